@@ -0,0 +1,148 @@
+// Package clock abstracts the time so that deadlines, expiries and background
+// jobs can be tested at their boundaries and tried out in simulated time.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the time and makes tickers. Everything in the service that
+// depends on the time goes through a Clock instead of the time package.
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks on C until it is stopped, like time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// Real returns the wall clock.
+func Real() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// Fake is a clock that only moves when told to, for tests. Its tickers fire
+// as the time is advanced past their period.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	tickers []*fakeTicker
+}
+
+// NewFake returns a fake clock stopped at now.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance moves the clock forward by d and fires the tickers that are due.
+func (f *Fake) Advance(d time.Duration) {
+	f.Set(f.Now().Add(d))
+}
+
+// Set moves the clock to now. Tickers only fire when the clock moves forward.
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+	live := f.tickers[:0]
+	for _, ticker := range f.tickers {
+		if ticker.stopped {
+			continue
+		}
+		// Like time.Ticker, a tick is dropped when the previous one was not read yet
+		for !ticker.next.After(now) {
+			select {
+			case ticker.c <- ticker.next:
+			default:
+			}
+			ticker.next = ticker.next.Add(ticker.period)
+		}
+		live = append(live, ticker)
+	}
+	f.tickers = live
+}
+
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ticker := &fakeTicker{clock: f, c: make(chan time.Time, 1), period: d, next: f.now.Add(d)}
+	f.tickers = append(f.tickers, ticker)
+	return ticker
+}
+
+type fakeTicker struct {
+	clock   *Fake
+	c       chan time.Time
+	period  time.Duration
+	next    time.Time
+	stopped bool
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTicker) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	t.stopped = true
+}
+
+// Simulated is a clock that starts at a chosen time and runs speed times
+// faster than real time. It backs the simulated time debug mode, where
+// deadlines and background jobs can be watched play out in minutes.
+type Simulated struct {
+	start     time.Time
+	realStart time.Time
+	speed     float64
+}
+
+// NewSimulated returns a clock showing start now and running at speed.
+func NewSimulated(start time.Time, speed float64) *Simulated {
+	if speed <= 0 {
+		speed = 1
+	}
+	return &Simulated{start: start, realStart: time.Now(), speed: speed}
+}
+
+func (s *Simulated) Now() time.Time {
+	elapsed := time.Since(s.realStart)
+	return s.start.Add(time.Duration(float64(elapsed) * s.speed))
+}
+
+// NewTicker ticks every d of simulated time. The ticks carry wall clock
+// times, read Now for the simulated one.
+func (s *Simulated) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(max(time.Duration(float64(d)/s.speed), time.Millisecond))}
+}
@@ -0,0 +1,49 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake_Ticker(t *testing.T) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := NewFake(start)
+	ticker := fake.NewTicker(time.Minute)
+
+	fake.Advance(59 * time.Second)
+	select {
+	case tick := <-ticker.C():
+		t.Fatalf("Ticked at %v before the period passed", tick)
+	default:
+	}
+
+	fake.Advance(time.Second)
+	select {
+	case tick := <-ticker.C():
+		if want := start.Add(time.Minute); !tick.Equal(want) {
+			t.Errorf("Tick = %v, want %v", tick, want)
+		}
+	default:
+		t.Fatal("No tick after the period passed")
+	}
+
+	ticker.Stop()
+	fake.Advance(time.Hour)
+	select {
+	case tick := <-ticker.C():
+		t.Errorf("A stopped ticker ticked at %v", tick)
+	default:
+	}
+	if got, want := fake.Now(), start.Add(time.Hour+time.Minute); !got.Equal(want) {
+		t.Errorf("Now() = %v, want %v", got, want)
+	}
+}
+
+func TestSimulated(t *testing.T) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	simulated := NewSimulated(start, 3600)
+	time.Sleep(10 * time.Millisecond)
+	if elapsed := simulated.Now().Sub(start); elapsed < 36*time.Second {
+		t.Errorf("Simulated clock advanced %v in 10ms at 3600x, want at least 36s", elapsed)
+	}
+}
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"net/http"
+
+	server "taskify/backend/server"
+)
+
+// ActorMiddleware attributes the changes made by a request to the user named
+// in the X-Taskify-User header.
+func ActorMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if actor := r.Header.Get(server.ActorMetadataKey); actor != "" {
+			r = r.WithContext(server.WithActor(r.Context(), actor))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+	validators "taskify/backend/validators"
+)
+
+// HTTPStatusFromCode maps a gRPC status code to the matching HTTP status.
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest // The task is in the wrong state, 412 is only for a failed If-Match
+	case codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+// WriteJSON writes a proto message as the JSON response body.
+func WriteJSON(w http.ResponseWriter, httpStatus int, message proto.Message) {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		WriteJSONError(w, status.Errorf(codes.Internal, "encoding response: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	w.Write(data)
+}
+
+// WriteJSONError writes err as a JSON body with the HTTP status matching its gRPC code.
+func WriteJSONError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeJSONStatus(w, HTTPStatusFromCode(st.Code()), st)
+}
+
+func writeJSONStatus(w http.ResponseWriter, httpStatus int, st *status.Status) {
+	body := struct {
+		Code            string            `json:"code"`
+		Message         string            `json:"message"`
+		FieldViolations map[string]string `json:"fieldViolations,omitempty"` // Invalid fields and why
+	}{
+		Code:            st.Code().String(),
+		Message:         st.Message(),
+		FieldViolations: validators.FieldViolations(st.Err()),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("Failed to write error response: %v", err)
+	}
+}
+
+// ReadJSON decodes the request body into a proto message.
+func ReadJSON(r *http.Request, message proto.Message) error {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "reading body: %v", err)
+	}
+	if err := protojson.Unmarshal(data, message); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid JSON body: %v", err)
+	}
+	return nil
+}
+
+// readOptionalJSON decodes the request body into a proto message, if there is one.
+func readOptionalJSON(r *http.Request, message proto.Message) error {
+	if r.ContentLength == 0 {
+		return nil
+	}
+	return ReadJSON(r, message)
+}
+
+// TaskETag is the entity tag of a task, it changes with every version.
+func TaskETag(task *pb.Task) string {
+	return fmt.Sprintf(`"%d-%d"`, task.TaskId, task.Version)
+}
+
+// ParseETagVersion returns the task version in an If-Match style header, 0 if
+// the header is empty or * for any version.
+func ParseETagVersion(header string, taskId int64) (int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	id, version, found := strings.Cut(tag, "-")
+	if !found || id != strconv.FormatInt(taskId, 10) {
+		return 0, status.Errorf(codes.Aborted, "entity tag %s does not match task %d", header, taskId)
+	}
+	v, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid entity tag %s", header)
+	}
+	return v, nil
+}
+
+// CreateTaskAPIHandler serves POST /api/tasks. Send an Idempotency-Key header
+// to retry safely, a retry gets the task created by the first request. A task
+// with the same text as an open one is only created with ?allowDuplicates=true.
+func CreateTaskAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	task := &pb.Task{}
+	if err := ReadJSON(r, task); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+
+	allowDuplicates := r.URL.Query().Get("allowDuplicates") == "true"
+	res, err := s.CreateTask(r.Context(), &pb.TaskRequest{Task: task, AllowDuplicates: allowDuplicates})
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/api/tasks/%d", res.Task.TaskId))
+	w.Header().Set("ETag", TaskETag(res.Task))
+	WriteJSON(w, http.StatusCreated, res.Task)
+}
+
+// GetTaskAPIHandler serves GET /api/tasks/{taskId} as JSON with an ETag.
+func GetTaskAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	id, err := ParseTaskId(r)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+
+	task, err := s.GetTask(r.Context(), id)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+
+	etag := TaskETag(task)
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	WriteJSON(w, http.StatusOK, task)
+}
+
+// UpdateTaskAPIHandler serves PUT /api/tasks/{taskId}. The version to update
+// comes from If-Match or the body, If-Match: * updates any version; a stale
+// version fails with 412.
+func UpdateTaskAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+
+	task := &pb.Task{}
+	if err := ReadJSON(r, task); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	task.TaskId = id
+
+	if !applyIfMatch(s, w, r, task) {
+		return
+	}
+
+	res, err := s.UpdateTask(r.Context(), &pb.TaskRequest{Task: task})
+	if err != nil {
+		writeConcurrencyError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", TaskETag(res.Task))
+	WriteJSON(w, http.StatusOK, res.Task)
+}
+
+// DeleteTaskAPIHandler serves DELETE /api/tasks/{taskId}, honoring If-Match.
+func DeleteTaskAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+
+	task := &pb.Task{TaskId: id}
+	if r.Header.Get("If-Match") != "" && !applyIfMatch(s, w, r, task) {
+		return
+	}
+
+	res, err := s.DeleteTask(r.Context(), &pb.TaskRequest{Task: task})
+	if err != nil {
+		writeConcurrencyError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, res)
+}
+
+// applyIfMatch sets the task version from the If-Match header, the current
+// version for If-Match: * unless the body has one. It writes the error response
+// and returns false when the header conflicts with the body or no version was
+// given at all.
+func applyIfMatch(s *server.Server, w http.ResponseWriter, r *http.Request, task *pb.Task) bool {
+	if strings.TrimSpace(r.Header.Get("If-Match")) == "*" && task.Version == 0 {
+		current, err := s.GetTask(r.Context(), task.TaskId)
+		if err != nil {
+			WriteJSONError(w, err)
+			return false
+		}
+		task.Version = current.Version
+		return true
+	}
+	version, err := ParseETagVersion(r.Header.Get("If-Match"), task.TaskId)
+	if err != nil {
+		writeConcurrencyError(w, err)
+		return false
+	}
+	switch {
+	case version == 0 && task.Version == 0:
+		writeJSONStatus(w, http.StatusPreconditionRequired, status.New(codes.FailedPrecondition, "send If-Match or a version to update a task"))
+		return false
+	case version != 0 && task.Version != 0 && version != task.Version:
+		writeJSONStatus(w, http.StatusPreconditionFailed, status.New(codes.Aborted, "If-Match does not match the version in the body"))
+		return false
+	case version != 0:
+		task.Version = version
+	}
+	return true
+}
+
+// writeConcurrencyError reports stale versions and entity tags as 412 Precondition Failed.
+func writeConcurrencyError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	if st.Code() == codes.Aborted {
+		writeJSONStatus(w, http.StatusPreconditionFailed, st)
+		return
+	}
+	WriteJSONError(w, err)
+}
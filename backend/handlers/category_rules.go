@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// CategorizeAPIHandler serves POST /api/categoryRules/dryRun, showing which
+// rules would fire for a sample task like {"task": {"title": "Fix CVE-2030-1"}}
+// and, with "rule", for a rule not saved yet.
+func CategorizeAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	in := &pb.CategorizeRequest{}
+	if err := ReadJSON(r, in); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	res, err := s.CategorizeTask(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, res)
+}
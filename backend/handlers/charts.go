@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"path/filepath"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// seriesRequest reads the from, until, list and tag query parameters.
+func seriesRequest(r *http.Request) *pb.SeriesRequest {
+	query := r.URL.Query()
+	return &pb.SeriesRequest{
+		From:  query.Get("from"),
+		Until: query.Get("until"),
+		List:  query.Get("list"),
+		Tag:   query.Get("tag"),
+	}
+}
+
+// TaskSeriesAPIHandler returns the open and completed tasks per day as JSON,
+// e.g. GET /api/stats/series?from=2024-12-01&until=2024-12-14&list=sprint
+func TaskSeriesAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	series, err := s.GetTaskSeries(r.Context(), seriesRequest(r))
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, series)
+}
+
+// ChartsPageHandler shows the burndown and cumulative flow charts, drawn in
+// the browser from TaskSeriesAPIHandler.
+func ChartsPageHandler(w http.ResponseWriter, r *http.Request) {
+	templatePath := filepath.Join("..", "frontend", "charts.html")
+	tmpl, err := template.ParseFiles(templatePath)
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Failed to load template: %s", templatePath))
+		return
+	}
+	if err := tmpl.Execute(w, seriesRequest(r)); err != nil {
+		log.Printf("Failed to render template charts.html: %v", err)
+	}
+}
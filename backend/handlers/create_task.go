@@ -2,32 +2,30 @@ package handlers
 
 import (
 	"fmt"
-	"html/template"
 	"net/http"
 
 	pb "taskify/backend/proto"
 	server "taskify/backend/server"
-	validators "taskify/backend/validators"
 )
 
 func CreateTaskHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
-	task, err := ParseForm(r, true)
-	if err != nil {
+	task, err := parseTaskForm(r, true)
+	if task == nil {
 		RenderErrorPage(w, fmt.Sprintf("Error Parsing the Form: %v", err))
 		return
 	}
-	// Validate the task
-	if err := validators.ValidateTask(task, false); err != nil {
-		RenderErrorPage(w, fmt.Sprintf("Invalid Argument creating the task: %v", err))
+	if err != nil {
+		renderTaskForm(w, r, "create_task.html", task, err)
 		return
 	}
 
-	// Call the CreateTask method from the server struct
-	_, err = s.CreateTask(r.Context(), &pb.TaskRequest{Task: task})
+	// CreateTask validates the task, the form is shown again with the problems next to the fields
+	allowDuplicates := r.FormValue("allowDuplicates") == "true"
+	_, err = s.CreateTask(r.Context(), &pb.TaskRequest{Task: task, AllowDuplicates: allowDuplicates})
 	if err != nil {
-		RenderErrorPage(w, err.Error())
+		renderTaskForm(w, r, "create_task.html", task, err)
 		return
 	}
 
@@ -41,16 +39,5 @@ func CreateTaskHandler(s *server.Server, w http.ResponseWriter, r *http.Request)
 }
 
 func CreateTaskPageHandler(w http.ResponseWriter, r *http.Request) {
-	// Define the path to your HTML file
-	tmpl, err := template.ParseFiles("../frontend/create_task.html")
-	if err != nil {
-		RenderErrorPage(w, "Failed to load template: "+err.Error())
-		return
-	}
-
-	// Render the template
-	err = tmpl.Execute(w, nil) // Pass any data if needed
-	if err != nil {
-		RenderErrorPage(w, "Error rendering template: "+err.Error())
-	}
+	executeTaskForm(w, r, "create_task.html", taskForm{Task: &pb.Task{}}, http.StatusOK)
 }
@@ -1,8 +1,8 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
-	"strconv"
 
 	pb "taskify/backend/proto"
 	server "taskify/backend/server"
@@ -11,21 +11,25 @@ import (
 func DeleteTaskHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
-	vars := mux.Vars(r)
-	taskId := vars["taskId"] // This is the TaskId part from the URL
-
-	// Convert the taskId to an integer (assuming taskId is an int64)
-	id, err := strconv.ParseInt(taskId, 10, 64)
+	// Convert the taskId from the URL to an integer
+	id, err := ParseTaskId(r)
 	if err != nil {
 		// Handle error if the TaskId is invalid
 		RenderErrorPage(w, "Invalid Task ID")
 		return
 	}
 
-	task := &pb.Task{
-		TaskId: id,
+	// The list page sends the version it showed, so a task edited meanwhile isn't trashed by accident
+	task, err := ParseForm(r, false)
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Error Parsing the Form: %v", err))
+		return
+	}
+	task = &pb.Task{
+		TaskId:  id,
+		Version: task.Version,
 	}
-	// Call the CreateTask method from the server struct
+	// Call the DeleteTask method from the server struct, the task goes to the trash
 	_, err = s.DeleteTask(r.Context(), &pb.TaskRequest{Task: task})
 	if err != nil {
 		RenderErrorPage(w, err.Error())
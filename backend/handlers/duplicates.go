@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// DuplicatesAPIHandler returns the duplicates of a task, or the groups of
+// duplicates among the open tasks without a taskId, as JSON,
+// e.g. GET /api/duplicates?taskId=12&threshold=0.9
+func DuplicatesAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	in := &pb.DuplicatesRequest{List: query.Get("list")}
+	if text := query.Get("taskId"); text != "" {
+		id, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			WriteJSONError(w, status.Error(codes.InvalidArgument, "Invalid Task ID"))
+			return
+		}
+		in.TaskId = id
+	}
+	if text := query.Get("threshold"); text != "" {
+		threshold, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			WriteJSONError(w, status.Errorf(codes.InvalidArgument, "invalid threshold: %s", text))
+			return
+		}
+		in.Threshold = threshold
+	}
+
+	duplicates, err := s.FindDuplicates(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, duplicates)
+}
+
+// MergeTasksAPIHandler serves POST /api/tasks/{taskId}/merge with a body like
+// {"sourceIds": ["13", "14"]}, honoring If-Match for the version of the task kept.
+func MergeTasksAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	in := &pb.MergeRequest{}
+	if err := ReadJSON(r, in); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	in.TargetId = id
+	if version, err := ParseETagVersion(r.Header.Get("If-Match"), id); err != nil {
+		writeConcurrencyError(w, err)
+		return
+	} else if version != 0 {
+		in.Version = version
+	}
+
+	res, err := s.MergeTasks(r.Context(), in)
+	if err != nil {
+		writeConcurrencyError(w, err)
+		return
+	}
+	w.Header().Set("ETag", TaskETag(res.Task))
+	WriteJSON(w, http.StatusOK, res.Task)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// StartFocusSessionAPIHandler serves POST /api/tasks/{taskId}/focus with an
+// optional body like {"minutes": 50, "note": "Outline"}.
+func StartFocusSessionAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	in := &pb.FocusRequest{}
+	if err := readOptionalJSON(r, in); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	in.TaskId = id
+
+	res, err := s.StartFocusSession(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, res)
+}
+
+// InterruptFocusSessionAPIHandler serves POST /api/focus/interrupt with a body
+// like {"note": "Phone call"}.
+func InterruptFocusSessionAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	in := &pb.FocusRequest{}
+	if err := readOptionalJSON(r, in); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	res, err := s.InterruptFocusSession(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, res)
+}
+
+// StopFocusSessionAPIHandler serves POST /api/focus/stop with an optional body
+// like {"note": "Outline done"}.
+func StopFocusSessionAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	in := &pb.FocusRequest{}
+	if err := readOptionalJSON(r, in); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	res, err := s.StopFocusSession(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, res)
+}
+
+// FocusSessionsAPIHandler returns the focus session history as JSON,
+// e.g. GET /api/focus/sessions?taskId=12&user=alice
+func FocusSessionsAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	in := &pb.FocusSessionsRequest{User: query.Get("user")}
+	if text := query.Get("taskId"); text != "" {
+		id, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			WriteJSONError(w, status.Error(codes.InvalidArgument, "Invalid Task ID"))
+			return
+		}
+		in.TaskId = id
+	}
+
+	sessions, err := s.ListFocusSessions(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, sessions)
+}
+
+// FocusStatsAPIHandler returns the focus sessions per day and task as JSON,
+// e.g. GET /api/focus/stats?from=2024-12-01&until=2024-12-07&user=alice
+func FocusStatsAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	stats, err := s.GetFocusStats(r.Context(), &pb.FocusStatsRequest{
+		From: query.Get("from"), Until: query.Get("until"), User: query.Get("user"),
+	})
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, stats)
+}
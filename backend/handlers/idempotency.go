@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"net/http"
+
+	server "taskify/backend/server"
+)
+
+// IdempotencyMiddleware passes the Idempotency-Key header on to the server, so
+// a retried request returns the response of the first one.
+func IdempotencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get(server.IdempotencyMetadataKey); key != "" {
+			r = r.WithContext(server.WithIdempotencyKey(r.Context(), key))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -10,6 +10,12 @@ import (
 	"taskify/backend/server"
 )
 
+// listTasksPage is the data of the list template.
+type listTasksPage struct {
+	Tasks       []*pb.Task
+	Suggestions []*pb.Suggestion // What to work on next, among the tasks of the list shown
+}
+
 func ListTasksHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
 	// Create a TaskRequest if necessary, or pass an empty one
 	task, err := ParseForm(r, false)
@@ -19,11 +25,18 @@ func ListTasksHandler(s *server.Server, w http.ResponseWriter, r *http.Request)
 	}
 
 	// Call ListTask method from server
-	tasks, err := s.ListTasks(r.Context(), &pb.TaskRequest{Task: task})
+	includeNotStarted := r.FormValue("includeNotStarted") == "true"
+	overdueOnly := r.FormValue("overdue") == "true"
+	tasks, err := s.ListTasks(r.Context(), &pb.TaskRequest{Task: task, IncludeNotStarted: includeNotStarted, OverdueOnly: overdueOnly})
 	if err != nil {
 		RenderErrorPage(w, fmt.Sprintf("Error fetching tasks: %v", err))
 		return
 	}
+	suggestions, err := s.SuggestNextTasks(r.Context(), &pb.SuggestRequest{Limit: 3, List: task.GetList()})
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Error suggesting tasks: %v", err))
+		return
+	}
 	// Get the current working directory
 	// baseDir, err := os.Getwd()
 	// if err != nil {
@@ -35,14 +48,14 @@ func ListTasksHandler(s *server.Server, w http.ResponseWriter, r *http.Request)
 	templatePath := filepath.Join("..", "frontend", "list_tasks.html")
 
 	// Parse the HTML file
-	tmpl, err := template.ParseFiles(templatePath)
+	tmpl, err := template.New("list_tasks.html").Funcs(templateFuncs(LocationFromContext(r.Context()))).ParseFiles(templatePath)
 	if err != nil {
 		RenderErrorPage(w, fmt.Sprintf("Failed to load template: %s", templatePath))
 		return
 	}
 
 	// Pass the list of tasks to the template
-	err = tmpl.Execute(w, tasks.Tasks)
+	err = tmpl.Execute(w, listTasksPage{Tasks: tasks.Tasks, Suggestions: suggestions.Suggestions})
 	if err != nil {
 		RenderErrorPage(w, fmt.Sprintf("Failed to render template:%v", tasks.Tasks))
 		return
@@ -3,28 +3,92 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+	validators "taskify/backend/validators"
 	"time"
+	"unicode"
 
+	"github.com/gorilla/mux"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func ParseForm(r *http.Request, isCreate bool) (*pb.Task, error) {
+	task, err := parseTaskForm(r, isCreate)
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// parseTaskForm reads a task from the form. The task holds every value that
+// could be read even when err reports invalid fields, so a form can be shown
+// again with what the user typed.
+func parseTaskForm(r *http.Request, isCreate bool) (*pb.Task, error) {
 	if err := r.ParseForm(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "Failed to parse form data")
 	}
+	var violations validators.Violations
 
 	// Get the deadline string from the form input (assuming it's in a format like "2024-12-03T10:30")
 	deadlineStr := r.FormValue("deadline")
 
-	// Parse the deadline string into a time.Time object (adjust format as necessary)
-	var deadline time.Time
+	// The deadline is entered in the user's time zone, an all-day deadline
+	// keeps only the date and is resolved to the end of that day by the server
+	var deadline int64
+	var deadlineDate string
 	if isCreate {
+		parsed, err := time.ParseInLocation(datetimeLocalLayout, deadlineStr, LocationFromContext(r.Context()))
+		switch {
+		case err != nil:
+			violations.Add("deadline", fmt.Sprintf("Invalid deadline format: %v", err))
+		case r.FormValue("allDay") == "true":
+			deadlineDate = parsed.Format(server.DeadlineDateLayout)
+		default:
+			deadline = parsed.Unix()
+		}
+	}
+
+	// The optional start hides the task until then, also in the user's time zone
+	var startAt int64
+	if startStr := r.FormValue("startAt"); isCreate && startStr != "" {
+		parsed, err := time.ParseInLocation(datetimeLocalLayout, startStr, LocationFromContext(r.Context()))
+		if err != nil {
+			violations.Add("startAt", fmt.Sprintf("Invalid start format: %v", err))
+		} else {
+			startAt = parsed.Unix()
+		}
+	}
+
+	priority := pb.Priority_PRIORITY_UNSPECIFIED
+	if name := r.FormValue("priority"); name != "" {
+		value, ok := pb.Priority_value[name]
+		if !ok {
+			violations.Add("priority", fmt.Sprintf("Invalid priority: %s", name))
+		}
+		priority = pb.Priority(value)
+	}
+
+	// Dependencies are task ids separated by spaces or commas
+	var dependsOn []int64
+	for _, field := range strings.FieldsFunc(r.FormValue("dependsOn"), func(c rune) bool { return c == ',' || unicode.IsSpace(c) }) {
+		id, err := strconv.ParseInt(strings.TrimPrefix(field, "#"), 10, 64)
+		if err != nil {
+			violations.Add("dependsOn", fmt.Sprintf("Invalid task id: %s", field))
+			continue
+		}
+		dependsOn = append(dependsOn, id)
+	}
+
+	var estimateMinutes int64
+	if estimateStr := r.FormValue("estimateMinutes"); estimateStr != "" {
 		var err error
-		deadline, err = time.Parse("2006-01-02T15:04", deadlineStr) // Adjust format if needed
+		estimateMinutes, err = strconv.ParseInt(estimateStr, 10, 32)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid deadline format: %v", err))
+			violations.Add("estimateMinutes", fmt.Sprintf("Invalid estimate: %v", err))
 		}
 	}
 
@@ -33,14 +97,42 @@ func ParseForm(r *http.Request, isCreate bool) (*pb.Task, error) {
 		complete = true
 	}
 
+	// The version the edit form was rendered with, used to detect concurrent edits
+	var version int64
+	if versionStr := r.FormValue("version"); versionStr != "" {
+		var err error
+		version, err = strconv.ParseInt(versionStr, 10, 64)
+		if err != nil {
+			violations.Add("version", fmt.Sprintf("Invalid version: %v", err))
+		}
+	}
+
 	// Create a TaskRequest from the form data
 
 	return &pb.Task{
-		Title:        r.FormValue("title"),
-		Description:  r.FormValue("description"),
-		ExitCriteria: r.FormValue("exitCriteria"),
-		Deadline:     deadline.Unix(),
-		Complete:     complete,
-	}, nil
+		Title:           r.FormValue("title"),
+		Description:     r.FormValue("description"),
+		ExitCriteria:    r.FormValue("exitCriteria"),
+		List:            r.FormValue("list"),
+		Assignee:        r.FormValue("assignee"),
+		Deadline:        deadline,
+		DeadlineDate:    deadlineDate,
+		Tags:            strings.Fields(r.FormValue("tags")),
+		Priority:        priority,
+		StartAt:         startAt,
+		DependsOn:       dependsOn,
+		EstimateMinutes: int32(estimateMinutes),
+		Complete:        complete,
+		Version:         version,
+	}, violations.Err()
 
 }
+
+// ParseTaskId reads the {taskId} route variable
+func ParseTaskId(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(mux.Vars(r)["taskId"], 10, 64)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "Invalid Task ID")
+	}
+	return id, nil
+}
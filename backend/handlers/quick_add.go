@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// QuickAddHandler creates a task from the one-line input of the list page.
+// When the line leaves out fields the task needs, the create form is shown
+// filled in with what was read from it.
+func QuickAddHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if err := r.ParseForm(); err != nil {
+		RenderErrorPage(w, "Failed to parse form data")
+		return
+	}
+	parsed, err := s.QuickAddTask(r.Context(), &pb.QuickAddRequest{Text: r.FormValue("text"), Preview: true})
+	if err != nil {
+		RenderErrorPage(w, err.Error())
+		return
+	}
+
+	task := parsed.Task
+	if _, err := s.CreateTask(r.Context(), &pb.TaskRequest{Task: task}); err != nil {
+		renderTaskForm(w, r, "create_task.html", task, err)
+		return
+	}
+
+	http.Redirect(w, r, "/listTasks", http.StatusSeeOther)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+	validators "taskify/backend/validators"
+)
+
+// settingsForm is the data of the settings template.
+type settingsForm struct {
+	*pb.UserSettings
+	Errors map[string]string
+}
+
+// weightFields are the suggestion weight inputs of the settings form.
+var weightFields = []struct {
+	name string
+	set  func(w *pb.SuggestionWeights, value float64)
+}{
+	{"deadline", func(w *pb.SuggestionWeights, value float64) { w.Deadline = value }},
+	{"priority", func(w *pb.SuggestionWeights, value float64) { w.Priority = value }},
+	{"unblocks", func(w *pb.SuggestionWeights, value float64) { w.Unblocks = value }},
+	{"age", func(w *pb.SuggestionWeights, value float64) { w.Age = value }},
+	{"effort", func(w *pb.SuggestionWeights, value float64) { w.Effort = value }},
+}
+
+func SettingsPageHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	settings, err := s.GetUserSettings(r.Context(), &pb.UserSettings{})
+	if err != nil {
+		RenderErrorPage(w, err.Error())
+		return
+	}
+	executeSettingsForm(w, settingsForm{UserSettings: settings}, http.StatusOK)
+}
+
+func UpdateSettingsHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if err := r.ParseForm(); err != nil {
+		RenderErrorPage(w, "Failed to parse form data")
+		return
+	}
+	settings := &pb.UserSettings{TimeZone: r.FormValue("timeZone"), SuggestionWeights: &pb.SuggestionWeights{}}
+	var violations validators.Violations
+	for _, field := range weightFields {
+		text := r.FormValue("weight." + field.name)
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			violations.Add("suggestionWeights."+field.name, fmt.Sprintf("Invalid weight: %s", text))
+		}
+		field.set(settings.SuggestionWeights, value)
+	}
+	err := violations.Err()
+	if err == nil {
+		_, err = s.UpdateUserSettings(r.Context(), settings)
+	}
+	if err != nil {
+		if status.Code(err) != codes.InvalidArgument {
+			RenderErrorPage(w, err.Error())
+			return
+		}
+		executeSettingsForm(w, settingsForm{UserSettings: settings, Errors: validators.FieldViolations(err)}, http.StatusBadRequest)
+		return
+	}
+
+	http.Redirect(w, r, "/listTasks", http.StatusSeeOther)
+}
+
+func executeSettingsForm(w http.ResponseWriter, form settingsForm, httpStatus int) {
+	templatePath := filepath.Join("..", "frontend", "settings.html")
+	tmpl, err := template.ParseFiles(templatePath)
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Failed to load template: %s", templatePath))
+		return
+	}
+
+	w.WriteHeader(httpStatus)
+	if err := tmpl.Execute(w, form); err != nil {
+		log.Printf("Failed to render template settings.html: %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// SnoozeTaskHandler hides a task for the duration in the "for" field, e.g. "24h".
+func SnoozeTaskHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		RenderErrorPage(w, "Invalid Task ID")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		RenderErrorPage(w, "Failed to parse form data")
+		return
+	}
+	duration, err := time.ParseDuration(r.FormValue("for"))
+	if err != nil || duration <= 0 {
+		RenderErrorPage(w, "Invalid snooze duration")
+		return
+	}
+	version, _ := strconv.ParseInt(r.FormValue("version"), 10, 64)
+
+	_, err = s.SnoozeTask(r.Context(), &pb.SnoozeRequest{TaskId: id, Version: version, Seconds: int64(duration / time.Second)})
+	if status.Code(err) == codes.Aborted {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		RenderErrorPage(w, "Someone else changed this task. Reload the list and try again.")
+		return
+	}
+	if err != nil {
+		RenderErrorPage(w, status.Convert(err).Message())
+		return
+	}
+
+	http.Redirect(w, r, "/listTasks", http.StatusSeeOther)
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// SuggestionsAPIHandler returns the tasks to work on next as JSON,
+// e.g. GET /api/suggestions?limit=3&availableMinutes=45&list=sprint
+func SuggestionsAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	in := &pb.SuggestRequest{List: query.Get("list")}
+	for name, value := range map[string]*int32{"limit": &in.Limit, "availableMinutes": &in.AvailableMinutes} {
+		if text := query.Get(name); text != "" {
+			n, err := strconv.ParseInt(text, 10, 32)
+			if err != nil {
+				WriteJSONError(w, status.Errorf(codes.InvalidArgument, "invalid %s: %s", name, text))
+				return
+			}
+			*value = int32(n)
+		}
+	}
+
+	suggestions, err := s.SuggestNextTasks(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, suggestions)
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// sseHeartbeat keeps idle connections from being closed by proxies.
+const sseHeartbeat = 30 * time.Second
+
+// TaskEventsHandler streams task changes as Server-Sent Events. The query
+// string filters tasks like the list page, and the Last-Event-ID header that
+// browsers send when reconnecting resumes right after the last event seen.
+func TaskEventsHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	filter, err := ParseForm(r, false)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := &pb.WatchTasksRequest{Filter: filter, ResumeToken: r.Header.Get("Last-Event-ID")}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Watch runs on its own goroutine so heartbeats and events never write concurrently
+	events := make(chan *pb.TaskEvent)
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- s.Watch(ctx, req, func(event *pb.TaskEvent) error {
+			select {
+			case events <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-watchErr:
+			if err != nil {
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", err.Error())
+				flusher.Flush()
+			}
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+		case event := <-events:
+			data, err := protojson.Marshal(event)
+			if err != nil {
+				log.Printf("Failed to encode task event %d: %v", event.Sequence, err)
+				continue
+			}
+			fmt.Fprintf(w, "id: %s\nevent: task\ndata: %s\n\n", event.ResumeToken, data)
+			flusher.Flush()
+		}
+	}
+}
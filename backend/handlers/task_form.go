@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"path/filepath"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+	validators "taskify/backend/validators"
+)
+
+// taskForm is the data of the create and edit templates: the submitted task,
+// the error of each invalid field, an error about the task as a whole and the
+// tasks it duplicates.
+type taskForm struct {
+	*pb.Task
+	Errors     map[string]string
+	Error      string
+	Duplicates []int64
+}
+
+// renderTaskForm shows a task form again with the error next to the fields it
+// names. Errors the user cannot fix in the form go to the error page instead.
+func renderTaskForm(w http.ResponseWriter, r *http.Request, page string, task *pb.Task, err error) {
+	st := status.Convert(err)
+	form := taskForm{Task: task, Errors: validators.FieldViolations(err)}
+	if len(form.Errors) == 0 {
+		if st.Code() != codes.InvalidArgument && st.Code() != codes.AlreadyExists {
+			RenderErrorPage(w, err.Error())
+			return
+		}
+		form.Error = st.Message()
+		form.Duplicates = server.DuplicateTaskIds(err)
+	}
+	executeTaskForm(w, r, page, form, HTTPStatusFromCode(st.Code()))
+}
+
+// executeTaskForm renders a task form template in the time zone of the user.
+func executeTaskForm(w http.ResponseWriter, r *http.Request, page string, form taskForm, httpStatus int) {
+	templatePath := filepath.Join("..", "frontend", page)
+	tmpl, err := template.New(page).Funcs(templateFuncs(LocationFromContext(r.Context()))).ParseFiles(templatePath)
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Failed to load template: %s", templatePath))
+		return
+	}
+
+	w.WriteHeader(httpStatus)
+	if err := tmpl.Execute(w, form); err != nil {
+		log.Printf("Failed to render template %s: %v", page, err)
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"path/filepath"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+func TaskHistoryHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	id, err := ParseTaskId(r)
+	if err != nil {
+		RenderErrorPage(w, "Invalid Task ID")
+		return
+	}
+
+	history, err := s.GetTaskHistory(r.Context(), &pb.TaskRequest{Task: &pb.Task{TaskId: id}})
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Error fetching the history: %v", err))
+		return
+	}
+
+	templatePath := filepath.Join("..", "frontend", "task_history.html")
+	tmpl, err := template.ParseFiles(templatePath)
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Failed to load template: %s", templatePath))
+		return
+	}
+
+	data := struct {
+		TaskId  int64
+		Changes []*pb.TaskChange
+	}{
+		TaskId:  id,
+		Changes: history.Changes,
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Failed to render template: %v", err))
+	}
+}
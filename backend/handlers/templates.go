@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// CreateFromTemplateAPIHandler serves POST /api/templates/{templateId}/tasks
+// with a body like {"variables": {"name": "Ada"}, "list": "onboarding"},
+// creating every task of the template at once.
+func CreateFromTemplateAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := strconv.ParseInt(mux.Vars(r)["templateId"], 10, 64)
+	if err != nil {
+		WriteJSONError(w, status.Error(codes.InvalidArgument, "Invalid Template ID"))
+		return
+	}
+	in := &pb.CreateFromTemplateRequest{}
+	if err := readOptionalJSON(r, in); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	in.TemplateId = id
+
+	res, err := s.CreateTaskFromTemplate(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusCreated, res)
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// timerRequest reads the task of a timer route and the optional body, like {"note": "Review"}.
+func timerRequest(r *http.Request) (*pb.TimerRequest, error) {
+	id, err := ParseTaskId(r)
+	if err != nil {
+		return nil, err
+	}
+	in := &pb.TimerRequest{}
+	if err := readOptionalJSON(r, in); err != nil {
+		return nil, err
+	}
+	in.TaskId = id
+	return in, nil
+}
+
+// StartTimerAPIHandler serves POST /api/tasks/{taskId}/timer/start, stopping
+// the timer the caller runs on another task.
+func StartTimerAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	in, err := timerRequest(r)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	res, err := s.StartTimer(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, res)
+}
+
+// StopTimerAPIHandler serves POST /api/tasks/{taskId}/timer/stop.
+func StopTimerAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	in, err := timerRequest(r)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	res, err := s.StopTimer(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, res)
+}
+
+// LogTimeAPIHandler serves POST /api/tasks/{taskId}/timeEntries with a body
+// like {"startedAt": "1733050800", "seconds": "1800", "note": "Call"}.
+func LogTimeAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	entry := &pb.TimeEntry{}
+	if err := ReadJSON(r, entry); err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	entry.TaskId = id
+
+	res, err := s.LogTime(r.Context(), &pb.TimeEntryRequest{Entry: entry})
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusCreated, res)
+}
+
+// TimeEntriesAPIHandler returns the time logged on a task, e.g.
+// GET /api/tasks/12/timeEntries, or on every task with GET /api/timeEntries?user=alice&running=true
+func TimeEntriesAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	in := &pb.TimeEntriesRequest{User: query.Get("user"), RunningOnly: query.Get("running") == "true"}
+	if _, ok := mux.Vars(r)["taskId"]; ok {
+		id, err := ParseTaskId(r)
+		if err != nil {
+			WriteJSONError(w, err)
+			return
+		}
+		in.TaskId = id
+	}
+
+	entries, err := s.ListTimeEntries(r.Context(), in)
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, entries)
+}
+
+// DeleteTimeEntryAPIHandler serves DELETE /api/timeEntries/{entryId}.
+func DeleteTimeEntryAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["entryId"], 10, 64)
+	if err != nil {
+		WriteJSONError(w, status.Error(codes.InvalidArgument, "Invalid Entry ID"))
+		return
+	}
+	res, err := s.DeleteTimeEntry(r.Context(), &pb.TimeEntryRequest{Entry: &pb.TimeEntry{EntryId: id}})
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, res)
+}
+
+// TimesheetAPIHandler returns the time spent per day and task as JSON,
+// e.g. GET /api/timesheet?from=2024-12-01&until=2024-12-07&user=alice
+func TimesheetAPIHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	timesheet, err := s.GetTimesheet(r.Context(), &pb.TimesheetRequest{
+		From: query.Get("from"), Until: query.Get("until"), User: query.Get("user"),
+	})
+	if err != nil {
+		WriteJSONError(w, err)
+		return
+	}
+	WriteJSON(w, http.StatusOK, timesheet)
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+// datetimeLocalLayout is the format of datetime-local inputs.
+const datetimeLocalLayout = "2006-01-02T15:04"
+
+type locationKey struct{}
+
+// TimeZoneMiddleware makes pages parse and show times in the time zone the
+// user chose in their settings. It must run after ActorMiddleware.
+func TimeZoneMiddleware(s *server.Server) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if loc, err := s.UserLocation(r.Context()); err == nil {
+				r = r.WithContext(context.WithValue(r.Context(), locationKey{}, loc))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// LocationFromContext returns the time zone of the user, UTC if unknown.
+func LocationFromContext(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(locationKey{}).(*time.Location); ok {
+		return loc
+	}
+	return time.UTC
+}
+
+// templateFuncs are available to every task template, showing times in loc.
+func templateFuncs(loc *time.Location) template.FuncMap {
+	return template.FuncMap{
+		// datetimeLocal formats a deadline for a datetime-local input, the way ParseForm reads it back
+		"datetimeLocal": func(task *pb.Task) string {
+			switch {
+			case task.DeadlineDate != "":
+				return task.DeadlineDate + "T23:59"
+			case task.Deadline == 0:
+				return ""
+			}
+			return time.Unix(task.Deadline, 0).In(loc).Format(datetimeLocalLayout)
+		},
+		// formatTime shows a Unix time to the user, empty for 0
+		"formatTime": func(unix int64) string {
+			if unix == 0 {
+				return ""
+			}
+			return time.Unix(unix, 0).In(loc).Format("2006-01-02 15:04 MST")
+		},
+		// startLocal formats a start time for a datetime-local input
+		"startLocal": func(unix int64) string {
+			if unix == 0 {
+				return ""
+			}
+			return time.Unix(unix, 0).In(loc).Format(datetimeLocalLayout)
+		},
+		// formatDeadline shows a deadline to the user, all-day deadlines as just their date
+		"formatDeadline": func(task *pb.Task) string {
+			switch {
+			case task.DeadlineDate != "":
+				return task.DeadlineDate + " (all day)"
+			case task.Deadline == 0:
+				return ""
+			}
+			return time.Unix(task.Deadline, 0).In(loc).Format("2006-01-02 15:04 MST")
+		},
+		// formatTags shows tags the way they are typed, e.g. "#finance #home"
+		"formatTags": func(tags []string) string {
+			formatted := make([]string, len(tags))
+			for i, tag := range tags {
+				formatted[i] = "#" + tag
+			}
+			return strings.Join(formatted, " ")
+		},
+		// formatIds shows task ids the way parseTaskForm reads them, e.g. "3 12"
+		"formatIds": func(ids []int64) string {
+			formatted := make([]string, len(ids))
+			for i, id := range ids {
+				formatted[i] = strconv.FormatInt(id, 10)
+			}
+			return strings.Join(formatted, " ")
+		},
+		"timeZone": func() string {
+			return loc.String()
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"path/filepath"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+func TrashHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	tasks, err := s.ListTrash(r.Context(), &pb.TaskRequest{})
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Error fetching the trash: %v", err))
+		return
+	}
+
+	templatePath := filepath.Join("..", "frontend", "trash.html")
+	tmpl, err := template.ParseFiles(templatePath)
+	if err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Failed to load template: %s", templatePath))
+		return
+	}
+
+	if err := tmpl.Execute(w, tasks.Tasks); err != nil {
+		RenderErrorPage(w, fmt.Sprintf("Failed to render template: %v", err))
+	}
+}
+
+func RestoreTaskHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		RenderErrorPage(w, "Invalid Task ID")
+		return
+	}
+
+	if _, err := s.RestoreTask(r.Context(), &pb.TaskRequest{Task: &pb.Task{TaskId: id}}); err != nil {
+		RenderErrorPage(w, err.Error())
+		return
+	}
+
+	http.Redirect(w, r, "/trash", http.StatusSeeOther)
+}
+
+func PurgeTaskHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		RenderErrorPage(w, "Invalid Task ID")
+		return
+	}
+
+	if _, err := s.PurgeTask(r.Context(), &pb.TaskRequest{Task: &pb.Task{TaskId: id}}); err != nil {
+		RenderErrorPage(w, err.Error())
+		return
+	}
+
+	http.Redirect(w, r, "/trash", http.StatusSeeOther)
+}
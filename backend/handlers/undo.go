@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"net/http"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+func UndoHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if _, err := s.UndoLastChange(r.Context(), &pb.TaskRequest{}); err != nil {
+		RenderErrorPage(w, err.Error())
+		return
+	}
+
+	http.Redirect(w, r, "/listTasks", http.StatusSeeOther)
+}
+
+func RedoHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if _, err := s.RedoLastChange(r.Context(), &pb.TaskRequest{}); err != nil {
+		RenderErrorPage(w, err.Error())
+		return
+	}
+
+	http.Redirect(w, r, "/listTasks", http.StatusSeeOther)
+}
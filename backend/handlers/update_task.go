@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	server "taskify/backend/server"
+)
+
+func EditTaskPageHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	id, err := ParseTaskId(r)
+	if err != nil {
+		RenderErrorPage(w, "Invalid Task ID")
+		return
+	}
+
+	task, err := s.GetTask(r.Context(), id)
+	if err != nil {
+		RenderErrorPage(w, err.Error())
+		return
+	}
+
+	w.Header().Set("ETag", TaskETag(task))
+	executeTaskForm(w, r, "edit_task.html", taskForm{Task: task}, http.StatusOK)
+}
+
+func UpdateTaskHandler(s *server.Server, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, err := ParseTaskId(r)
+	if err != nil {
+		RenderErrorPage(w, "Invalid Task ID")
+		return
+	}
+
+	task, err := parseTaskForm(r, true)
+	if task == nil {
+		RenderErrorPage(w, fmt.Sprintf("Error Parsing the Form: %v", err))
+		return
+	}
+	task.TaskId = id
+	if err != nil {
+		renderTaskForm(w, r, "edit_task.html", task, err)
+		return
+	}
+
+	// Scripts may send the ETag they read instead of the hidden version field
+	if version, err := ParseETagVersion(r.Header.Get("If-Match"), id); err != nil {
+		RenderErrorPage(w, err.Error())
+		return
+	} else if version != 0 {
+		task.Version = version
+	}
+
+	_, err = s.UpdateTask(r.Context(), &pb.TaskRequest{Task: task})
+	if status.Code(err) == codes.Aborted {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		RenderErrorPage(w, "Someone else changed this task while you were editing it. Reload it and apply your changes again.")
+		return
+	}
+	if err != nil {
+		renderTaskForm(w, r, "edit_task.html", task, err)
+		return
+	}
+
+	http.Redirect(w, r, "/listTasks", http.StatusSeeOther)
+}
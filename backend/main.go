@@ -1,17 +1,23 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"strconv"
+	"taskify/backend/clock"
 	"taskify/backend/handlers"
+	"time"
 
 	"github.com/gorilla/mux"
 	"google.golang.org/grpc"
 
 	pb "taskify/backend/proto"
 	server "taskify/backend/server"
+	validators "taskify/backend/validators"
 )
 
 func main() {
@@ -27,8 +33,70 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	// Deleted tasks stay in the trash for TRASH_RETENTION (e.g. "720h")
+	trashRetention := server.DefaultTrashRetention
+	if value := os.Getenv("TRASH_RETENTION"); value != "" {
+		trashRetention, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("invalid TRASH_RETENTION %q: %v", value, err)
+		}
+	}
+
+	// Changes can be undone for UNDO_WINDOW (e.g. "15m")
+	undoWindow := server.DefaultUndoWindow
+	if value := os.Getenv("UNDO_WINDOW"); value != "" {
+		undoWindow, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("invalid UNDO_WINDOW %q: %v", value, err)
+		}
+	}
+
+	// Responses to requests with an idempotency key are replayed for IDEMPOTENCY_TTL (e.g. "24h")
+	idempotencyTTL := server.DefaultIdempotencyTTL
+	if value := os.Getenv("IDEMPOTENCY_TTL"); value != "" {
+		idempotencyTTL, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("invalid IDEMPOTENCY_TTL %q: %v", value, err)
+		}
+	}
+
+	// Debug mode: SIMULATED_TIME (RFC 3339, e.g. "2030-01-01T09:00:00Z") starts the service clock
+	// at that time, running SIMULATED_TIME_SPEED (default 1) times faster than real time
+	clk := clock.Real()
+	if value := os.Getenv("SIMULATED_TIME"); value != "" {
+		start, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			log.Fatalf("invalid SIMULATED_TIME %q: %v", value, err)
+		}
+		speed := 1.0
+		if value := os.Getenv("SIMULATED_TIME_SPEED"); value != "" {
+			speed, err = strconv.ParseFloat(value, 64)
+			if err != nil || speed <= 0 {
+				log.Fatalf("invalid SIMULATED_TIME_SPEED %q", value)
+			}
+		}
+		clk = clock.NewSimulated(start, speed)
+		log.Printf("Running in simulated time from %s at %gx speed", start.Format(time.RFC3339), speed)
+	}
+
+	// Task validation limits and per-list rules come from the JSON file VALIDATION_CONFIG
+	validationConfig := validators.DefaultConfig()
+	if path := os.Getenv("VALIDATION_CONFIG"); path != "" {
+		validationConfig, err = validators.LoadConfig(path)
+		if err != nil {
+			log.Fatalf("invalid VALIDATION_CONFIG: %v", err)
+		}
+	}
+	validator, err := validators.New(validationConfig, validators.WithClock(clk))
+	if err != nil {
+		log.Fatalf("invalid validation config: %v", err)
+	}
+
 	//
-	srv := &server.Server{Db: db}
+	srv := &server.Server{Db: db, TrashRetention: trashRetention, UndoWindow: undoWindow, IdempotencyTTL: idempotencyTTL, Validator: validator, Clock: clk}
+	srv.StartTrashPurger(context.Background(), time.Hour)
+	srv.StartWebhookDispatcher(context.Background(), 10*time.Second)
+	srv.StartOverdueChecker(context.Background(), time.Minute)
 	// Create a new gRPC server
 	grpcServer := grpc.NewServer()
 
@@ -42,13 +110,159 @@ func main() {
 	}).Methods("POST")
 	r.HandleFunc("/createTask", handlers.CreateTaskPageHandler).Methods("GET")
 
+	r.HandleFunc("/quickAdd", func(w http.ResponseWriter, r *http.Request) {
+		handlers.QuickAddHandler(srv, w, r)
+	}).Methods("POST")
+
 	r.HandleFunc("/listTasks", func(w http.ResponseWriter, r *http.Request) {
 		handlers.ListTasksHandler(srv, w, r)
 	}).Methods("GET")
 
 	r.HandleFunc("/deleteTask/{taskId}", func(w http.ResponseWriter, r *http.Request) {
 		handlers.DeleteTaskHandler(srv, w, r)
-	}).Methods("GET", "POST")
+	}).Methods("POST") // Never delete on GET, a stray link or prefetch must not trash a task
+
+	r.HandleFunc("/snoozeTask/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.SnoozeTaskHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/editTask/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.EditTaskPageHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/updateTask/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.UpdateTaskHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/tasks", func(w http.ResponseWriter, r *http.Request) {
+		handlers.CreateTaskAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/tasks/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.GetTaskAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/tasks/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.UpdateTaskAPIHandler(srv, w, r)
+	}).Methods("PUT")
+
+	r.HandleFunc("/api/tasks/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.DeleteTaskAPIHandler(srv, w, r)
+	}).Methods("DELETE")
+
+	r.HandleFunc("/api/tasks/{taskId}/merge", func(w http.ResponseWriter, r *http.Request) {
+		handlers.MergeTasksAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/categoryRules/dryRun", func(w http.ResponseWriter, r *http.Request) {
+		handlers.CategorizeAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/duplicates", func(w http.ResponseWriter, r *http.Request) {
+		handlers.DuplicatesAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/stats/series", func(w http.ResponseWriter, r *http.Request) {
+		handlers.TaskSeriesAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/suggestions", func(w http.ResponseWriter, r *http.Request) {
+		handlers.SuggestionsAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/tasks/{taskId}/timer/start", func(w http.ResponseWriter, r *http.Request) {
+		handlers.StartTimerAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/tasks/{taskId}/timer/stop", func(w http.ResponseWriter, r *http.Request) {
+		handlers.StopTimerAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/tasks/{taskId}/timeEntries", func(w http.ResponseWriter, r *http.Request) {
+		handlers.LogTimeAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/tasks/{taskId}/timeEntries", func(w http.ResponseWriter, r *http.Request) {
+		handlers.TimeEntriesAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/timeEntries", func(w http.ResponseWriter, r *http.Request) {
+		handlers.TimeEntriesAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/timeEntries/{entryId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.DeleteTimeEntryAPIHandler(srv, w, r)
+	}).Methods("DELETE")
+
+	r.HandleFunc("/api/timesheet", func(w http.ResponseWriter, r *http.Request) {
+		handlers.TimesheetAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/tasks/{taskId}/focus", func(w http.ResponseWriter, r *http.Request) {
+		handlers.StartFocusSessionAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/focus/interrupt", func(w http.ResponseWriter, r *http.Request) {
+		handlers.InterruptFocusSessionAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/focus/stop", func(w http.ResponseWriter, r *http.Request) {
+		handlers.StopFocusSessionAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/api/focus/sessions", func(w http.ResponseWriter, r *http.Request) {
+		handlers.FocusSessionsAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/focus/stats", func(w http.ResponseWriter, r *http.Request) {
+		handlers.FocusStatsAPIHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/api/templates/{templateId}/tasks", func(w http.ResponseWriter, r *http.Request) {
+		handlers.CreateFromTemplateAPIHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/charts", handlers.ChartsPageHandler).Methods("GET")
+
+	r.HandleFunc("/taskEvents", func(w http.ResponseWriter, r *http.Request) {
+		handlers.TaskEventsHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/trash", func(w http.ResponseWriter, r *http.Request) {
+		handlers.TrashHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/restoreTask/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.RestoreTaskHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/purgeTask/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.PurgeTaskHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/taskHistory/{taskId}", func(w http.ResponseWriter, r *http.Request) {
+		handlers.TaskHistoryHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/settings", func(w http.ResponseWriter, r *http.Request) {
+		handlers.SettingsPageHandler(srv, w, r)
+	}).Methods("GET")
+
+	r.HandleFunc("/settings", func(w http.ResponseWriter, r *http.Request) {
+		handlers.UpdateSettingsHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/undo", func(w http.ResponseWriter, r *http.Request) {
+		handlers.UndoHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.HandleFunc("/redo", func(w http.ResponseWriter, r *http.Request) {
+		handlers.RedoHandler(srv, w, r)
+	}).Methods("POST")
+
+	r.Use(handlers.ActorMiddleware)
+	r.Use(handlers.TimeZoneMiddleware(srv))
+	r.Use(handlers.IdempotencyMiddleware)
 
 	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		handlers.RenderErrorPage(w, "Page not found.")
@@ -56,7 +270,7 @@ func main() {
 
 	go func() {
 		fmt.Println("HTTP Server running on port 8080")
-		if err := http.ListenAndServe(":8080", nil); err != nil {
+		if err := http.ListenAndServe(":8080", r); err != nil {
 			log.Fatalf("failed to start HTTP server: %v", err)
 		}
 	}()
@@ -0,0 +1,298 @@
+// Package quickadd reads a task written as one line of text, like
+// "Pay invoice tomorrow 5pm #finance !high".
+package quickadd
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "taskify/backend/proto"
+)
+
+// DateLayout is the format of dates written as numbers and of all-day deadlines.
+const DateLayout = "2006-01-02"
+
+var priorities = map[string]pb.Priority{
+	"low":    pb.Priority_LOW,
+	"medium": pb.Priority_MEDIUM,
+	"med":    pb.Priority_MEDIUM,
+	"high":   pb.Priority_HIGH,
+	"urgent": pb.Priority_URGENT,
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+var months = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+var units = map[string]time.Duration{
+	"minute": time.Minute, "minutes": time.Minute, "min": time.Minute, "mins": time.Minute,
+	"hour": time.Hour, "hours": time.Hour, "hr": time.Hour, "hrs": time.Hour,
+	"day": 24 * time.Hour, "days": 24 * time.Hour,
+	"week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+}
+
+var (
+	tagPattern        = regexp.MustCompile(`^#([\pL\pN_-]+)$`)
+	clockPattern      = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+	dayOfMonthPattern = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)?$`)
+)
+
+// prepositions may introduce a date or time and are dropped with it.
+var prepositions = map[string]bool{"on": true, "at": true, "by": true, "due": true}
+
+// parser holds what has been read from the line so far.
+type parser struct {
+	now   time.Time
+	words []string
+
+	title    []string
+	tags     []string
+	priority pb.Priority
+	date     *time.Time     // Day of the deadline
+	clock    *time.Duration // Time of day of the deadline
+	instant  *time.Time     // Deadline given as a duration from now
+}
+
+// Parse reads a task from text. Dates and times are relative to now and in
+// its location. The first date and the first time of day make the deadline,
+// a date alone makes an all-day deadline and a time alone is the next time
+// the clock shows it. #words become tags, !low, !medium, !high and !urgent
+// set the priority and everything else is the title.
+func Parse(text string, now time.Time) *pb.Task {
+	p := &parser{now: now, words: strings.Fields(text)}
+	for i := 0; i < len(p.words); {
+		i += p.read(i)
+	}
+
+	task := &pb.Task{Title: strings.Join(p.title, " "), Tags: p.tags, Priority: p.priority}
+	switch {
+	case p.date != nil && p.clock != nil:
+		task.Deadline = at(*p.date, *p.clock).Unix()
+	case p.date != nil:
+		task.DeadlineDate = p.date.Format(DateLayout)
+	case p.clock != nil:
+		deadline := at(now, *p.clock)
+		if !deadline.After(now) {
+			deadline = at(now.AddDate(0, 0, 1), *p.clock)
+		}
+		task.Deadline = deadline.Unix()
+	case p.instant != nil:
+		task.Deadline = p.instant.Unix()
+	}
+	return task
+}
+
+// read consumes the words starting at i and returns how many it used.
+func (p *parser) read(i int) int {
+	word := p.words[i]
+	lower := strings.ToLower(word)
+
+	if match := tagPattern.FindStringSubmatch(word); match != nil {
+		if tag := strings.ToLower(match[1]); !slices.Contains(p.tags, tag) {
+			p.tags = append(p.tags, tag)
+			slices.Sort(p.tags)
+		}
+		return 1
+	}
+	if priority, ok := priorities[strings.TrimPrefix(lower, "!")]; ok && strings.HasPrefix(lower, "!") && p.priority == pb.Priority_PRIORITY_UNSPECIFIED {
+		p.priority = priority
+		return 1
+	}
+
+	skip := 0
+	if prepositions[lower] && i+1 < len(p.words) {
+		skip = 1 // "at 5pm", only dropped when a date or time follows
+	}
+	if n := p.readWhen(i + skip); n > 0 {
+		return skip + n
+	}
+
+	p.title = append(p.title, word)
+	return 1
+}
+
+// readWhen reads a date or time of day at i, if the deadline has none yet.
+func (p *parser) readWhen(i int) int {
+	if i >= len(p.words) {
+		return 0
+	}
+	if p.date == nil && p.instant == nil {
+		if date, n := p.readDate(i); n > 0 {
+			p.date = &date
+			return n
+		}
+		if instant, wholeDays, n := p.readDuration(i); n > 0 {
+			if wholeDays {
+				date := midnight(instant) // "in 3 days" is a day, not an instant
+				p.date = &date
+			} else {
+				p.instant = &instant
+			}
+			return n
+		}
+	}
+	if p.clock == nil {
+		if clock, n := p.readClock(i); n > 0 {
+			p.clock = &clock
+			return n
+		}
+	}
+	return 0
+}
+
+// readDate reads today, tomorrow, a weekday, 2024-12-03, "dec 3" or "3 dec".
+func (p *parser) readDate(i int) (time.Time, int) {
+	word := strings.ToLower(strings.TrimRight(p.words[i], ",."))
+	today := midnight(p.now)
+	switch word {
+	case "today", "tonight":
+		return today, 1
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), 1
+	case "next":
+		if i+1 < len(p.words) {
+			if weekday, ok := weekdays[strings.ToLower(p.words[i+1])]; ok {
+				return nextWeekday(today, weekday), 2
+			}
+		}
+		return time.Time{}, 0
+	}
+	if weekday, ok := weekdays[word]; ok {
+		return nextWeekday(today, weekday), 1
+	}
+	if date, err := time.ParseInLocation(DateLayout, word, p.now.Location()); err == nil {
+		return date, 1
+	}
+	if i+1 >= len(p.words) {
+		return time.Time{}, 0
+	}
+	next := strings.ToLower(strings.TrimRight(p.words[i+1], ",."))
+	if month, ok := months[word]; ok {
+		if date, ok := p.dayOfMonth(month, next); ok {
+			return date, 2
+		}
+	}
+	if month, ok := months[next]; ok {
+		if date, ok := p.dayOfMonth(month, word); ok {
+			return date, 2
+		}
+	}
+	return time.Time{}, 0
+}
+
+// dayOfMonth returns the next date that is day of month, this year or next.
+func (p *parser) dayOfMonth(month time.Month, day string) (time.Time, bool) {
+	match := dayOfMonthPattern.FindStringSubmatch(day)
+	if match == nil {
+		return time.Time{}, false
+	}
+	n, _ := strconv.Atoi(match[1])
+	today := midnight(p.now)
+	date := time.Date(today.Year(), month, n, 0, 0, 0, 0, today.Location())
+	if date.Month() != month || date.Day() != n {
+		return time.Time{}, false // Like February 30
+	}
+	if date.Before(today) {
+		date = date.AddDate(1, 0, 0)
+	}
+	return date, true
+}
+
+// readDuration reads "in 3 days", "in an hour" or "in 30 min", and whether
+// it counted whole days.
+func (p *parser) readDuration(i int) (time.Time, bool, int) {
+	if strings.ToLower(p.words[i]) != "in" || i+2 >= len(p.words) {
+		return time.Time{}, false, 0
+	}
+	count := strings.ToLower(p.words[i+1])
+	n, err := strconv.Atoi(count)
+	if count == "a" || count == "an" {
+		n, err = 1, nil
+	}
+	unit, ok := units[strings.ToLower(strings.TrimRight(p.words[i+2], ",."))]
+	if err != nil || !ok || n <= 0 {
+		return time.Time{}, false, 0
+	}
+	if unit >= 24*time.Hour {
+		return p.now.AddDate(0, 0, n*int(unit/(24*time.Hour))), true, 3
+	}
+	return p.now.Add(time.Duration(n) * unit), false, 3
+}
+
+// readClock reads a time of day like 5pm, "5 pm", 5:30pm, 17:30 or noon.
+func (p *parser) readClock(i int) (time.Duration, int) {
+	word := strings.ToLower(strings.TrimRight(p.words[i], ",."))
+	if word == "noon" {
+		return 12 * time.Hour, 1
+	}
+	n := 1
+	if i+1 < len(p.words) && !strings.ContainsAny(word, ":apm") {
+		if suffix := strings.ToLower(strings.TrimRight(p.words[i+1], ",.")); suffix == "am" || suffix == "pm" {
+			word += suffix
+			n = 2
+		}
+	}
+	match := clockPattern.FindStringSubmatch(word)
+	if match == nil || (match[2] == "" && match[3] == "") {
+		return 0, 0 // A bare number is not a time
+	}
+	hour, _ := strconv.Atoi(match[1])
+	minute, _ := strconv.Atoi(match[2])
+	switch {
+	case minute > 59:
+		return 0, 0
+	case match[3] != "" && (hour < 1 || hour > 12):
+		return 0, 0
+	case match[3] == "" && hour > 23:
+		return 0, 0
+	case match[3] == "am" && hour == 12:
+		hour = 0
+	case match[3] == "pm" && hour != 12:
+		hour += 12
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, n
+}
+
+// midnight returns the start of the day of t in its location.
+func midnight(t time.Time) time.Time {
+	return at(t, 0)
+}
+
+// at returns the time of day clock on the day of t, by the wall clock so a
+// change to or from daylight saving time that day does not shift it.
+func at(t time.Time, clock time.Duration) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0, t.Location())
+}
+
+// nextWeekday returns the first day after today that is weekday.
+func nextWeekday(today time.Time, weekday time.Weekday) time.Time {
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return today.AddDate(0, 0, days)
+}
@@ -0,0 +1,71 @@
+package quickadd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	pb "taskify/backend/proto"
+)
+
+func TestParse(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+	now := time.Date(2024, 12, 3, 9, 30, 0, 0, paris) // A Tuesday
+	unix := func(year int, month time.Month, day, hour, minute int) int64 {
+		return time.Date(year, month, day, hour, minute, 0, 0, paris).Unix()
+	}
+
+	tests := []struct {
+		text string
+		want *pb.Task
+	}{
+		{"Pay invoice tomorrow 5pm #finance !high", &pb.Task{
+			Title: "Pay invoice", Deadline: unix(2024, 12, 4, 17, 0), Tags: []string{"finance"}, Priority: pb.Priority_HIGH,
+		}},
+		{"Call Bob at 14:15", &pb.Task{Title: "Call Bob", Deadline: unix(2024, 12, 3, 14, 15)}},
+		{"Stand-up 9am", &pb.Task{Title: "Stand-up", Deadline: unix(2024, 12, 4, 9, 0)}}, // 9am has passed today
+		{"Water plants 5 pm", &pb.Task{Title: "Water plants", Deadline: unix(2024, 12, 3, 17, 0)}},
+		{"Lunch noon", &pb.Task{Title: "Lunch", Deadline: unix(2024, 12, 3, 12, 0)}},
+		{"Submit report friday", &pb.Task{Title: "Submit report", DeadlineDate: "2024-12-06"}},
+		{"Retro next tuesday 10:00", &pb.Task{Title: "Retro", Deadline: unix(2024, 12, 10, 10, 0)}},
+		{"Renew passport by jan 15th", &pb.Task{Title: "Renew passport", DeadlineDate: "2025-01-15"}},
+		{"Party 24 dec 8pm #home #Friends", &pb.Task{
+			Title: "Party", Deadline: unix(2024, 12, 24, 20, 0), Tags: []string{"friends", "home"},
+		}},
+		{"File taxes on 2025-04-30 !urgent", &pb.Task{Title: "File taxes", DeadlineDate: "2025-04-30", Priority: pb.Priority_URGENT}},
+		{"Check oven in 20 min", &pb.Task{Title: "Check oven", Deadline: now.Add(20 * time.Minute).Unix()}},
+		{"Follow up in 2 weeks", &pb.Task{Title: "Follow up", DeadlineDate: "2024-12-17"}},
+		{"Meet at the cafe", &pb.Task{Title: "Meet at the cafe"}},
+		{"Buy 3 apples", &pb.Task{Title: "Buy 3 apples"}},
+		{"Read chapter 12:30pm tomorrow !low !high", &pb.Task{
+			Title: "Read chapter !high", Deadline: unix(2024, 12, 4, 12, 30), Priority: pb.Priority_LOW,
+		}},
+		{"Fix bug #42 before Feb 30", &pb.Task{Title: "Fix bug before Feb 30", Tags: []string{"42"}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			got := Parse(tc.text, now)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(pb.Task{})); diff != "" {
+				t.Errorf("Parse(%q) (-want,+got):%v", tc.text, diff)
+			}
+		})
+	}
+}
+
+func TestParse_DaylightSavingTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+	now := time.Date(2025, 3, 8, 12, 0, 0, 0, newYork) // Clocks go forward the next night
+
+	got := Parse("Brunch tomorrow 11am", now)
+	if want := time.Date(2025, 3, 9, 11, 0, 0, 0, newYork).Unix(); got.Deadline != want {
+		t.Errorf("Deadline = %v, want 11:00 by the wall clock", time.Unix(got.Deadline, 0).In(newYork))
+	}
+}
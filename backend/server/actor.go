@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ActorMetadataKey is the gRPC metadata key, and HTTP header, naming the user making a request.
+const ActorMetadataKey = "x-taskify-user"
+
+const (
+	AnonymousActor  = "anonymous"  // Requests that don't name a user
+	SystemActor     = "system"     // Changes made by background jobs
+	AutomationActor = "automation" // Changes made by automations
+)
+
+type actorKey struct{}
+
+// WithActor returns a context attributing the changes made with it to actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, strings.TrimSpace(actor))
+}
+
+// ActorFromContext returns the user making the request, read from WithActor or
+// the incoming gRPC metadata.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	if values := metadata.ValueFromIncomingContext(ctx, ActorMetadataKey); len(values) > 0 {
+		if actor := strings.TrimSpace(values[0]); actor != "" {
+			return actor
+		}
+	}
+	return AnonymousActor
+}
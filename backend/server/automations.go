@@ -0,0 +1,643 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// Automation run statuses
+const (
+	RunSucceeded = "succeeded"
+	RunFailed    = "failed"
+	RunSkipped   = "skipped"
+)
+
+// MaxAutomationDepth is how many automations one change can set off in a
+// row, counting those triggered by the changes of other automations.
+const MaxAutomationDepth = 3
+
+const automationColumns = "automationId, name, triggerType, definition, active, createdAt"
+
+const automationRunColumns = "runId, automationId, taskId, triggerType, depth, status, error, changes, at"
+
+const notificationColumns = "notificationId, recipient, taskId, automationId, policyId, message, createdAt, readAt"
+
+// eventTriggers maps the change events to the trigger they fire.
+var eventTriggers = map[pb.EventType]pb.Trigger{
+	pb.EventType_TASK_CREATED:   pb.Trigger_WHEN_CREATED,
+	pb.EventType_TASK_UPDATED:   pb.Trigger_WHEN_UPDATED,
+	pb.EventType_TASK_COMPLETED: pb.Trigger_WHEN_COMPLETED,
+	pb.EventType_TASK_DELETED:   pb.Trigger_WHEN_DELETED,
+}
+
+// scanAutomation reads a row selected with automationColumns.
+func scanAutomation(row rowScanner) (*pb.Automation, error) {
+	automation := &pb.Automation{}
+	var trigger, definition string
+	if err := row.Scan(&automation.AutomationId, &automation.Name, &trigger, &definition, &automation.Active, &automation.CreatedAt); err != nil {
+		return nil, err
+	}
+	stored := &pb.Automation{}
+	if err := protojson.Unmarshal([]byte(definition), stored); err != nil {
+		return nil, err
+	}
+	automation.Trigger = pb.Trigger(pb.Trigger_value[trigger])
+	automation.Condition, automation.Actions = stored.Condition, stored.Actions
+	return automation, nil
+}
+
+// scanAutomationRun reads a row selected with automationRunColumns.
+func scanAutomationRun(row rowScanner) (*pb.AutomationRun, error) {
+	run := &pb.AutomationRun{}
+	var trigger, changes string
+	err := row.Scan(&run.RunId, &run.AutomationId, &run.TaskId, &trigger, &run.Depth, &run.Status, &run.Error, &changes, &run.At)
+	if err != nil {
+		return nil, err
+	}
+	run.Trigger = pb.Trigger(pb.Trigger_value[trigger])
+	if changes != "" {
+		run.Changes = strings.Split(changes, "\n")
+	}
+	return run, nil
+}
+
+// marshalDefinition encodes the condition and actions of an automation for the definition column.
+func marshalDefinition(automation *pb.Automation) (string, error) {
+	data, err := protojson.Marshal(&pb.Automation{Condition: automation.Condition, Actions: automation.Actions})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "encoding automation: %v", err)
+	}
+	return string(data), nil
+}
+
+// isTaskChange reports whether an action changes the task that triggered it.
+func isTaskChange(action pb.ActionType) bool {
+	switch action {
+	case pb.ActionType_SET_PRIORITY, pb.ActionType_RAISE_PRIORITY, pb.ActionType_ADD_TAGS, pb.ActionType_SET_LIST, pb.ActionType_SET_ASSIGNEE:
+		return true
+	}
+	return false
+}
+
+// normalizeAutomation trims an automation and reports every invalid field.
+func normalizeAutomation(automation *pb.Automation) error {
+	automation.Name = strings.TrimSpace(automation.Name)
+	if automation.Condition == nil {
+		automation.Condition = &pb.AutomationCondition{}
+	}
+	condition := automation.Condition
+	condition.Tags = normalizeTags(condition.Tags)
+	condition.List = strings.TrimSpace(condition.List)
+	condition.Assignee = strings.TrimSpace(condition.Assignee)
+	condition.TitleContains = strings.TrimSpace(condition.TitleContains)
+
+	violations := &validators.Violations{}
+	switch {
+	case automation.Trigger == pb.Trigger_TRIGGER_UNSPECIFIED:
+		violations.Add("trigger", "set a trigger")
+	case pb.Trigger_name[int32(automation.Trigger)] == "":
+		violations.Add("trigger", fmt.Sprintf("unknown trigger %d", automation.Trigger))
+	}
+	if _, ok := pb.Priority_name[int32(condition.MinPriority)]; !ok {
+		violations.Add("condition.minPriority", fmt.Sprintf("unknown priority %d", condition.MinPriority))
+	}
+	if condition.TitleContains != "" && normalizeText(condition.TitleContains) == "" {
+		violations.Add("condition.titleContains", "the text has no letters or digits")
+	}
+	if len(automation.Actions) == 0 {
+		violations.Add("actions", "add at least one action")
+	}
+	for i, action := range automation.Actions {
+		field := fmt.Sprintf("actions[%d]", i)
+		switch action.Type {
+		case pb.ActionType_CREATE_TASK:
+			if action.Task == nil || strings.TrimSpace(action.Task.Title) == "" {
+				violations.Add(field+".task", "the task to create has no title")
+			}
+			if action.DeadlineSeconds < 0 {
+				violations.Add(field+".deadlineSeconds", "the deadline is before the trigger")
+			}
+		case pb.ActionType_SET_PRIORITY:
+			if _, ok := pb.Priority_name[int32(action.Priority)]; !ok || action.Priority == pb.Priority_PRIORITY_UNSPECIFIED {
+				violations.Add(field+".priority", "set a priority")
+			}
+		case pb.ActionType_ADD_TAGS:
+			if action.Tags = normalizeTags(action.Tags); len(action.Tags) == 0 {
+				violations.Add(field+".tags", "add at least one tag")
+			}
+		case pb.ActionType_SET_LIST:
+			action.List = strings.TrimSpace(action.List)
+		case pb.ActionType_SET_ASSIGNEE:
+			action.Assignee = strings.TrimSpace(action.Assignee)
+		case pb.ActionType_NOTIFY:
+			action.Recipient = strings.TrimSpace(action.Recipient)
+		case pb.ActionType_RAISE_PRIORITY:
+		case pb.ActionType_ACTION_TYPE_UNSPECIFIED:
+			violations.Add(field+".type", "set an action type")
+		default:
+			violations.Add(field+".type", fmt.Sprintf("unknown action type %d", action.Type))
+		}
+		if automation.Trigger == pb.Trigger_WHEN_DELETED && isTaskChange(action.Type) {
+			violations.Add(field+".type", "tasks in the trash can't be changed, create a task or notify instead")
+		}
+	}
+	return violations.Err()
+}
+
+func (s *Server) getAutomation(id int64) (*pb.Automation, error) {
+	automation, err := scanAutomation(s.Db.QueryRow("SELECT "+automationColumns+" FROM automations WHERE automationId = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "automation %d not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading automation %d: %v", id, err)
+	}
+	return automation, nil
+}
+
+// CreateAutomation adds an active automation.
+func (s *Server) CreateAutomation(ctx context.Context, in *pb.AutomationRequest) (*pb.AutomationResponse, error) {
+	if in == nil || in.Automation == nil {
+		return nil, status.Error(codes.InvalidArgument, "Automation is nil")
+	}
+	automation := proto.Clone(in.Automation).(*pb.Automation)
+	if err := normalizeAutomation(automation); err != nil {
+		return nil, err
+	}
+	definition, err := marshalDefinition(automation)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "INSERT INTO automations (name, triggerType, definition, active, createdAt) VALUES (?, ?, ?, 1, ?)"
+	res, err := s.Db.Exec(query, automation.Name, automation.Trigger.String(), definition, s.now().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating automation: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating automation: %v", err)
+	}
+	automation, err = s.getAutomation(id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AutomationResponse{Automation: automation}, nil
+}
+
+// UpdateAutomation replaces every field of an automation but its creation time,
+// and its active flag when the request sets it. Its runs are kept, so overdue
+// tasks it already ran for are not run again.
+func (s *Server) UpdateAutomation(ctx context.Context, in *pb.AutomationRequest) (*pb.AutomationResponse, error) {
+	if in == nil || in.Automation == nil {
+		return nil, status.Error(codes.InvalidArgument, "Automation is nil")
+	}
+	if in.Automation.AutomationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "AutomationId is empty")
+	}
+	automation := proto.Clone(in.Automation).(*pb.Automation)
+	if err := normalizeAutomation(automation); err != nil {
+		return nil, err
+	}
+	definition, err := marshalDefinition(automation)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "UPDATE automations SET name = ?, triggerType = ?, definition = ?, active = COALESCE(?, active) WHERE automationId = ?"
+	res, err := s.Db.Exec(query, automation.Name, automation.Trigger.String(), definition, automation.Active, automation.AutomationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "updating automation %d: %v", automation.AutomationId, err)
+	}
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "automation %d not found", automation.AutomationId)
+	}
+	automation, err = s.getAutomation(automation.AutomationId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AutomationResponse{Automation: automation}, nil
+}
+
+// DeleteAutomation removes an automation together with its runs. The
+// notifications it sent stay with their recipients.
+func (s *Server) DeleteAutomation(ctx context.Context, in *pb.AutomationRequest) (*pb.DeleteAutomationResponse, error) {
+	if in == nil || in.Automation == nil {
+		return nil, status.Error(codes.InvalidArgument, "Automation is nil")
+	}
+
+	var deleted bool
+	err := s.inTx(func(tx *txn) error {
+		res, err := tx.Exec("DELETE FROM automations WHERE automationId = ?", in.Automation.AutomationId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "deleting automation %d: %v", in.Automation.AutomationId, err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return status.Errorf(codes.Internal, "deleting automation %d: %v", in.Automation.AutomationId, err)
+		}
+		if rowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		if _, err := tx.Exec("DELETE FROM automation_runs WHERE automationId = ?", in.Automation.AutomationId); err != nil {
+			return status.Errorf(codes.Internal, "deleting runs of automation %d: %v", in.Automation.AutomationId, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteAutomationResponse{Success: deleted}, nil
+}
+
+// ListAutomations returns every automation, oldest first
+func (s *Server) ListAutomations(ctx context.Context, in *pb.AutomationRequest) (*pb.ListAutomationsResponse, error) {
+	automations, err := loadAutomations(s.Db, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAutomationsResponse{Automations: automations}, nil
+}
+
+// loadAutomations loads the automations oldest first, only the active ones if activeOnly.
+func loadAutomations(q queryer, activeOnly bool) ([]*pb.Automation, error) {
+	query := "SELECT " + automationColumns + " FROM automations"
+	if activeOnly {
+		query += " WHERE active = 1"
+	}
+	rows, err := q.Query(query + " ORDER BY automationId ASC")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the automations: %v", err)
+	}
+	defer rows.Close()
+
+	var automations []*pb.Automation
+	for rows.Next() {
+		automation, err := scanAutomation(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reading an automation: %v", err)
+		}
+		automations = append(automations, automation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the automations: %v", err)
+	}
+	return automations, nil
+}
+
+// ListAutomationRuns returns the execution log, most recent first
+func (s *Server) ListAutomationRuns(ctx context.Context, in *pb.AutomationRunsRequest) (*pb.AutomationRunsResponse, error) {
+	if in == nil {
+		in = &pb.AutomationRunsRequest{}
+	}
+	query := "SELECT " + automationRunColumns + " FROM automation_runs WHERE 1=1"
+	var args []any
+	if in.AutomationId != 0 {
+		query += " AND automationId = ?"
+		args = append(args, in.AutomationId)
+	}
+	if in.TaskId != 0 {
+		query += " AND taskId = ?"
+		args = append(args, in.TaskId)
+	}
+	rows, err := s.Db.Query(query+" ORDER BY runId DESC", args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to query automation runs: %v", err))
+	}
+	defer rows.Close()
+
+	var runs []*pb.AutomationRun
+	for rows.Next() {
+		run, err := scanAutomationRun(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to scan row: %v", err))
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Error encountered during iteration: %v", err))
+	}
+	return &pb.AutomationRunsResponse{Runs: runs}, nil
+}
+
+// matchCondition reports whether task meets every field set in condition.
+func matchCondition(condition *pb.AutomationCondition, task *pb.Task) bool {
+	if condition == nil {
+		return true
+	}
+	for _, tag := range condition.Tags {
+		if !slices.Contains(task.Tags, tag) {
+			return false
+		}
+	}
+	switch {
+	case condition.List != "" && task.List != condition.List:
+		return false
+	case condition.MinPriority != pb.Priority_PRIORITY_UNSPECIFIED && task.Priority < condition.MinPriority:
+		return false
+	case condition.Assignee != "" && task.Assignee != condition.Assignee:
+		return false
+	case condition.TitleContains != "":
+		return strings.Contains(" "+normalizeText(task.Title)+" ", " "+normalizeText(condition.TitleContains)+" ")
+	}
+	return true
+}
+
+// runAutomations runs the active automations triggered by the events of tx,
+// and by the events those automations cause in turn, before tx commits.
+func (s *Server) runAutomations(tx *txn) error {
+	if len(tx.events) == 0 {
+		return nil
+	}
+	automations, err := loadAutomations(tx, true)
+	if err != nil || len(automations) == 0 {
+		return err
+	}
+	// Automations append events while this runs, those are handled too
+	for i := 0; i < len(tx.events); i++ {
+		event := tx.events[i]
+		trigger, ok := eventTriggers[event.Type]
+		if !ok {
+			continue
+		}
+		key := "event:" + strconv.FormatInt(event.Sequence, 10)
+		for _, automation := range automations {
+			if automation.Trigger != trigger || !matchCondition(automation.Condition, event.Task) {
+				continue
+			}
+			if err := s.runAutomation(tx, automation, event.Task, key, tx.automationChains[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runAutomation runs the actions of automation for task and logs the run.
+// chain lists the automations whose changes led here, the run is skipped if
+// it would repeat one of them or make the chain longer than MaxAutomationDepth.
+// Failed actions are rolled back without failing tx.
+func (s *Server) runAutomation(tx *txn, automation *pb.Automation, task *pb.Task, key string, chain []int64) error {
+	run := &pb.AutomationRun{
+		AutomationId: automation.AutomationId,
+		TaskId:       task.TaskId,
+		Trigger:      automation.Trigger,
+		Depth:        int32(len(chain)),
+		At:           tx.now.Unix(),
+	}
+	switch {
+	case slices.Contains(chain, automation.AutomationId):
+		run.Status, run.Error = RunSkipped, "the automation already ran in this chain"
+	case len(chain) >= MaxAutomationDepth:
+		run.Status, run.Error = RunSkipped, fmt.Sprintf("the chain already ran %d automations", MaxAutomationDepth)
+	default:
+		if _, err := tx.Exec("SAVEPOINT automation"); err != nil {
+			return status.Errorf(codes.Internal, "creating savepoint: %v", err)
+		}
+		queued := len(tx.events)
+		changes, err := s.runActions(tx, automation, task)
+		if err != nil {
+			tx.events = tx.events[:queued] // The rolled back changes never happened
+			if _, rollbackErr := tx.Exec("ROLLBACK TO automation; RELEASE automation"); rollbackErr != nil {
+				return status.Errorf(codes.Internal, "rolling back to savepoint: %v", rollbackErr)
+			}
+			run.Status, run.Error = RunFailed, status.Convert(err).Message()
+			break
+		}
+		if _, err := tx.Exec("RELEASE automation"); err != nil {
+			return status.Errorf(codes.Internal, "releasing savepoint: %v", err)
+		}
+		run.Status, run.Changes = RunSucceeded, changes
+		if tx.automationChains == nil {
+			tx.automationChains = map[int][]int64{}
+		}
+		for i := queued; i < len(tx.events); i++ {
+			tx.automationChains[i] = append(slices.Clone(chain), automation.AutomationId)
+		}
+	}
+
+	query := `INSERT INTO automation_runs (automationId, taskId, triggerType, triggerKey, depth, status, error, changes, at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := tx.Exec(query, run.AutomationId, run.TaskId, run.Trigger.String(), key, run.Depth, run.Status, run.Error,
+		strings.Join(run.Changes, "\n"), run.At)
+	if err != nil {
+		return status.Errorf(codes.Internal, "logging the run of automation %d: %v", automation.AutomationId, err)
+	}
+	return nil
+}
+
+// runActions applies the actions of automation to task as AutomationActor
+// and describes what they did. Changes to task are saved as one update after
+// the other actions ran.
+func (s *Server) runActions(tx *txn, automation *pb.Automation, task *pb.Task) ([]string, error) {
+	ctx := WithActor(context.Background(), AutomationActor)
+	current, err := getTask(tx, task.TaskId) // Earlier automations may have changed it since the event
+	if err != nil {
+		return nil, err
+	}
+	updated := proto.Clone(current).(*pb.Task)
+
+	var changes []string
+	for _, action := range automation.Actions {
+		switch action.Type {
+		case pb.ActionType_CREATE_TASK:
+			created, err := s.createTask(ctx, tx, followUpTask(action, current, tx.now))
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, fmt.Sprintf("created task %d", created.TaskId))
+		case pb.ActionType_SET_PRIORITY, pb.ActionType_RAISE_PRIORITY:
+			priority := action.Priority
+			if action.Type == pb.ActionType_RAISE_PRIORITY {
+				priority = min(updated.Priority+1, pb.Priority_URGENT)
+			}
+			if updated.Priority != priority {
+				updated.Priority = priority
+				changes = append(changes, "priority "+priority.String())
+			}
+		case pb.ActionType_ADD_TAGS:
+			for _, tag := range action.Tags {
+				if !slices.Contains(updated.Tags, tag) {
+					updated.Tags = append(updated.Tags, tag)
+					changes = append(changes, "tag "+tag)
+				}
+			}
+			updated.Tags = normalizeTags(updated.Tags)
+		case pb.ActionType_SET_LIST:
+			if updated.List != action.List {
+				updated.List = action.List
+				changes = append(changes, "list "+action.List)
+			}
+		case pb.ActionType_SET_ASSIGNEE:
+			if updated.Assignee != action.Assignee {
+				updated.Assignee = action.Assignee
+				changes = append(changes, "assignee "+action.Assignee)
+			}
+		case pb.ActionType_NOTIFY:
+			recipient := cmp.Or(action.Recipient, current.Assignee)
+			if recipient == "" {
+				changes = append(changes, "nobody notified, the task has no assignee")
+				continue
+			}
+			message := cmp.Or(expandPlaceholders(action.Message, current), fmt.Sprintf("%q ran for task %d %q", automation.Name, current.TaskId, current.Title))
+			notification := &pb.Notification{Recipient: recipient, TaskId: current.TaskId, AutomationId: automation.AutomationId, Message: message}
+			if err := notify(tx, notification); err != nil {
+				return nil, err
+			}
+			changes = append(changes, "notified "+recipient)
+		}
+	}
+	if !proto.Equal(updated, current) {
+		if _, err := s.updateTask(ctx, tx, updated); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// followUpTask builds the task a CREATE_TASK action creates for task.
+func followUpTask(action *pb.AutomationAction, task *pb.Task, now time.Time) *pb.Task {
+	followUp := proto.Clone(action.Task).(*pb.Task)
+	followUp.TaskId, followUp.Version, followUp.DeletedAt = 0, 0, 0
+	followUp.Title = expandPlaceholders(followUp.Title, task)
+	followUp.Description = expandPlaceholders(followUp.Description, task)
+	followUp.ExitCriteria = expandPlaceholders(followUp.ExitCriteria, task)
+	followUp.List = cmp.Or(followUp.List, task.List)
+	followUp.Assignee = cmp.Or(followUp.Assignee, task.Assignee)
+	if action.DeadlineSeconds > 0 {
+		followUp.Deadline = now.Add(time.Duration(action.DeadlineSeconds) * time.Second).Unix()
+		followUp.DeadlineDate, followUp.DeadlineTime = "", nil
+	}
+	return followUp
+}
+
+// expandPlaceholders replaces the placeholders of an action text with the fields of task.
+func expandPlaceholders(text string, task *pb.Task) string {
+	return strings.NewReplacer(
+		"{{title}}", task.Title,
+		"{{taskId}}", strconv.FormatInt(task.TaskId, 10),
+		"{{assignee}}", task.Assignee,
+	).Replace(text)
+}
+
+// notify stores a notification inside tx, sent now.
+func notify(tx *txn, notification *pb.Notification) error {
+	query := "INSERT INTO notifications (recipient, taskId, automationId, policyId, message, createdAt) VALUES (?, ?, ?, ?, ?, ?)"
+	_, err := tx.Exec(query, notification.Recipient, notification.TaskId, notification.AutomationId, notification.PolicyId, notification.Message, tx.now.Unix())
+	if err != nil {
+		return status.Errorf(codes.Internal, "notifying %s: %v", notification.Recipient, err)
+	}
+	return nil
+}
+
+// ListNotifications returns the notifications of the caller, most recent
+// first. With markRead the unread ones are marked read once listed, and are
+// returned as they were before.
+func (s *Server) ListNotifications(ctx context.Context, in *pb.NotificationsRequest) (*pb.NotificationsResponse, error) {
+	if in == nil {
+		in = &pb.NotificationsRequest{}
+	}
+	recipient := ActorFromContext(ctx)
+	var notifications []*pb.Notification
+	err := s.inTx(func(tx *txn) error {
+		query := "SELECT " + notificationColumns + " FROM notifications WHERE recipient = ?"
+		if in.UnreadOnly {
+			query += " AND readAt = 0"
+		}
+		rows, err := tx.Query(query+" ORDER BY notificationId DESC", recipient)
+		if err != nil {
+			return status.Errorf(codes.Internal, "reading the notifications: %v", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			notification := &pb.Notification{}
+			err := rows.Scan(&notification.NotificationId, &notification.Recipient, &notification.TaskId, &notification.AutomationId,
+				&notification.PolicyId, &notification.Message, &notification.CreatedAt, &notification.ReadAt)
+			if err != nil {
+				return status.Errorf(codes.Internal, "reading a notification: %v", err)
+			}
+			notifications = append(notifications, notification)
+		}
+		if err := rows.Err(); err != nil {
+			return status.Errorf(codes.Internal, "reading the notifications: %v", err)
+		}
+		rows.Close()
+
+		if in.MarkRead && len(notifications) > 0 {
+			query := "UPDATE notifications SET readAt = ? WHERE recipient = ? AND readAt = 0 AND notificationId <= ?"
+			if _, err := tx.Exec(query, tx.now.Unix(), recipient, notifications[0].NotificationId); err != nil {
+				return status.Errorf(codes.Internal, "marking the notifications read: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.NotificationsResponse{Notifications: notifications}, nil
+}
+
+// RunOverdueAutomations runs the WHEN_OVERDUE automations for the open tasks
+// whose deadline has passed. An automation runs once per task and deadline,
+// so a task that is rescheduled and becomes overdue again runs it again.
+// It returns how many runs it logged.
+func (s *Server) RunOverdueAutomations(ctx context.Context) (int, error) {
+	var runs int
+	err := s.inTx(func(tx *txn) error {
+		automations, err := loadAutomations(tx, true)
+		if err != nil {
+			return err
+		}
+		automations = slices.DeleteFunc(automations, func(automation *pb.Automation) bool {
+			return automation.Trigger != pb.Trigger_WHEN_OVERDUE
+		})
+		if len(automations) == 0 {
+			return nil
+		}
+		query := "SELECT " + taskColumns + " FROM tasks WHERE deletedAt = 0 AND complete = 0 AND deadline != 0 AND deadline < ? ORDER BY taskId ASC"
+		tasks, err := queryTasks(tx, query, tx.now.Unix())
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			key := "overdue:" + strconv.FormatInt(task.Deadline, 10)
+			for _, automation := range automations {
+				if !matchCondition(automation.Condition, task) {
+					continue
+				}
+				var ran bool
+				query := "SELECT EXISTS (SELECT 1 FROM automation_runs WHERE automationId = ? AND taskId = ? AND triggerKey = ?)"
+				if err := tx.QueryRow(query, automation.AutomationId, task.TaskId, key).Scan(&ran); err != nil {
+					return status.Errorf(codes.Internal, "reading the runs of automation %d: %v", automation.AutomationId, err)
+				}
+				if ran {
+					continue
+				}
+				if err := s.runAutomation(tx, automation, task, key, nil); err != nil {
+					return err
+				}
+				runs++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return runs, nil
+}
@@ -0,0 +1,275 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+func TestAutomations(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC))
+	validator, err := validators.New(validators.DefaultConfig(), validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}
+	createAutomation := func(automation *pb.Automation) *pb.Automation {
+		t.Helper()
+		res, err := testServer.CreateAutomation(ctx, &pb.AutomationRequest{Automation: automation})
+		if err != nil {
+			t.Fatalf("CreateAutomation(%s): %v", automation.Name, err)
+		}
+		return res.Automation
+	}
+	followUp := createAutomation(&pb.Automation{
+		Name: "Announce releases", Trigger: pb.Trigger_WHEN_COMPLETED,
+		Condition: &pb.AutomationCondition{Tags: []string{"release"}},
+		Actions: []*pb.AutomationAction{{
+			Type: pb.ActionType_CREATE_TASK, DeadlineSeconds: 2 * 24 * 60 * 60,
+			Task: &pb.Task{Title: "Announce {{title}}", Description: "Follow-up of task {{taskId}}", ExitCriteria: "Posted", Tags: []string{"comms"}},
+		}},
+	})
+	// Changes the task it runs for, which would trigger it again without the loop protection
+	raise := createAutomation(&pb.Automation{
+		Name: "Raise updated releases", Trigger: pb.Trigger_WHEN_UPDATED,
+		Condition: &pb.AutomationCondition{Tags: []string{"release"}, TitleContains: "release"},
+		Actions:   []*pb.AutomationAction{{Type: pb.ActionType_RAISE_PRIORITY}},
+	})
+
+	res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+		Title: "Release v2", Description: "Ship it", ExitCriteria: "Tagged",
+		Deadline: fake.Now().Add(time.Hour).Unix(), Tags: []string{"release"}, Priority: pb.Priority_LOW,
+	}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	release := res.Task
+
+	release.Description = "Ship it today"
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: release}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	release, _ = testServer.GetTask(ctx, release.TaskId)
+	if release.Priority != pb.Priority_MEDIUM {
+		t.Errorf("Priority after one update = %v, want MEDIUM", release.Priority)
+	}
+	runs, err := testServer.ListAutomationRuns(ctx, &pb.AutomationRunsRequest{AutomationId: raise.AutomationId})
+	if err != nil {
+		t.Fatalf("ListAutomationRuns: %v", err)
+	}
+	var got [][]any
+	for _, run := range runs.Runs {
+		got = append(got, []any{run.Status, run.Depth, run.Error, run.Changes})
+	}
+	want := [][]any{
+		{RunSkipped, int32(1), "the automation already ran in this chain", []string(nil)},
+		{RunSucceeded, int32(0), "", []string{"priority MEDIUM"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Runs of %s (-want,+got):%v", raise.Name, diff)
+	}
+
+	release.Complete = true
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: release}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	runs, err = testServer.ListAutomationRuns(ctx, &pb.AutomationRunsRequest{AutomationId: followUp.AutomationId})
+	if err != nil || len(runs.Runs) != 1 || runs.Runs[0].Status != RunSucceeded || len(runs.Runs[0].Changes) != 1 {
+		t.Fatalf("ListAutomationRuns = %v, %v, want one successful run", runs, err)
+	}
+	tasks, err := testServer.ListTask(ctx, &pb.TaskRequest{})
+	if err != nil || len(tasks.Tasks) != 2 {
+		t.Fatalf("ListTask = %v, %v, want the release and its follow-up", tasks, err)
+	}
+	announce := tasks.Tasks[1]
+	if announce.Title != "Announce Release v2" || announce.Description != "Follow-up of task 1" || announce.Deadline != fake.Now().Add(48*time.Hour).Unix() {
+		t.Errorf("Follow-up = %v, want the release announcement due in two days", announce)
+	}
+	history, err := testServer.GetTaskHistory(ctx, &pb.TaskRequest{Task: announce})
+	if err != nil || history.Changes[0].Actor != AutomationActor {
+		t.Errorf("GetTaskHistory = %v, %v, want a creation by %s", history, err, AutomationActor)
+	}
+}
+
+func TestUpdateAutomation_Active(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	res, err := testServer.CreateAutomation(ctx, &pb.AutomationRequest{Automation: &pb.Automation{
+		Name: "Raise new tasks", Trigger: pb.Trigger_WHEN_CREATED, Actions: []*pb.AutomationAction{{Type: pb.ActionType_RAISE_PRIORITY}},
+	}})
+	if err != nil {
+		t.Fatalf("CreateAutomation: %v", err)
+	}
+	automation := res.Automation
+	priorityOf := func(title string) pb.Priority {
+		t.Helper()
+		created, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "New", ExitCriteria: "Done", Deadline: time.Now().Add(time.Hour).Unix(), Priority: pb.Priority_LOW,
+		}})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", title, err)
+		}
+		task, _ := testServer.GetTask(ctx, created.Task.TaskId)
+		return task.Priority
+	}
+
+	// An update that leaves out the active flag keeps it
+	automation.Name, automation.Active = "Raise every new task", nil
+	updated, err := testServer.UpdateAutomation(ctx, &pb.AutomationRequest{Automation: automation})
+	if err != nil {
+		t.Fatalf("UpdateAutomation: %v", err)
+	}
+	if !updated.Automation.GetActive() {
+		t.Errorf("UpdateAutomation without active = %v, want it still active", updated.Automation)
+	}
+	if priority := priorityOf("First"); priority != pb.Priority_MEDIUM {
+		t.Errorf("Priority with the automation active = %v, want MEDIUM", priority)
+	}
+
+	automation.Active = proto.Bool(false)
+	if _, err := testServer.UpdateAutomation(ctx, &pb.AutomationRequest{Automation: automation}); err != nil {
+		t.Fatalf("UpdateAutomation: %v", err)
+	}
+	if priority := priorityOf("Second"); priority != pb.Priority_LOW {
+		t.Errorf("Priority with the automation inactive = %v, want LOW", priority)
+	}
+}
+
+func TestAutomations_FailedActionsRollBack(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	_, err := testServer.CreateAutomation(ctx, &pb.AutomationRequest{Automation: &pb.Automation{
+		Name: "Review new tasks", Trigger: pb.Trigger_WHEN_CREATED,
+		Actions: []*pb.AutomationAction{
+			{Type: pb.ActionType_ADD_TAGS, Tags: []string{"triage"}},
+			{Type: pb.ActionType_CREATE_TASK, Task: &pb.Task{Title: "Review {{title}}", Description: "Review", ExitCriteria: "Reviewed"}},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateAutomation: %v", err)
+	}
+
+	res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+		Title: "Write docs", Description: "Docs", ExitCriteria: "Published", Deadline: time.Now().Add(time.Hour).Unix(),
+	}})
+	if err != nil {
+		t.Fatalf("CreateTask with a failing automation: %v", err)
+	}
+	if len(res.Task.Tags) != 0 {
+		t.Errorf("Tags = %v, want none", res.Task.Tags)
+	}
+	// The review task has no deadline, so the whole automation is rolled back
+	runs, err := testServer.ListAutomationRuns(ctx, &pb.AutomationRunsRequest{TaskId: res.Task.TaskId})
+	if err != nil || len(runs.Runs) != 1 || runs.Runs[0].Status != RunFailed || runs.Runs[0].Error != "deadline is missing" {
+		t.Errorf("ListAutomationRuns = %v, %v, want a run failed for the missing deadline", runs, err)
+	}
+	tasks, err := testServer.ListTask(ctx, &pb.TaskRequest{})
+	if err != nil || len(tasks.Tasks) != 1 {
+		t.Errorf("ListTask = %v, %v, want only the created task", tasks, err)
+	}
+	stored, err := testServer.GetTask(ctx, res.Task.TaskId)
+	if err != nil || len(stored.Tags) != 0 || stored.Version != 1 {
+		t.Errorf("GetTask = %v, %v, want the task as created", stored, err)
+	}
+}
+
+func TestRunOverdueAutomations(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC))
+	validator, err := validators.New(validators.DefaultConfig(), validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}
+	_, err = testServer.CreateAutomation(ctx, &pb.AutomationRequest{Automation: &pb.Automation{
+		Name: "Escalate overdue tasks", Trigger: pb.Trigger_WHEN_OVERDUE,
+		Actions: []*pb.AutomationAction{
+			{Type: pb.ActionType_RAISE_PRIORITY},
+			{Type: pb.ActionType_NOTIFY, Message: "{{title}} is overdue"},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateAutomation: %v", err)
+	}
+	create := func(title string, deadline time.Duration) *pb.Task {
+		t.Helper()
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "Due", ExitCriteria: "Done", Deadline: fake.Now().Add(deadline).Unix(), Assignee: "alice",
+		}})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", title, err)
+		}
+		return res.Task
+	}
+	late := create("Pay invoice", time.Hour)
+	create("Renew domain", 48*time.Hour)
+
+	fake.Advance(2 * time.Hour)
+	for i, want := range []int{1, 0} {
+		runs, err := testServer.RunOverdueAutomations(ctx)
+		if err != nil || runs != want {
+			t.Errorf("RunOverdueAutomations #%d = %d, %v, want %d runs", i+1, runs, err, want)
+		}
+	}
+	late, _ = testServer.GetTask(ctx, late.TaskId)
+	if late.Priority != pb.Priority_LOW {
+		t.Errorf("Priority of the overdue task = %v, want LOW", late.Priority)
+	}
+
+	alice := WithActor(ctx, "alice")
+	notifications, err := testServer.ListNotifications(alice, &pb.NotificationsRequest{UnreadOnly: true, MarkRead: true})
+	if err != nil || len(notifications.Notifications) != 1 || notifications.Notifications[0].Message != "Pay invoice is overdue" {
+		t.Fatalf("ListNotifications = %v, %v, want the overdue invoice", notifications, err)
+	}
+	notifications, err = testServer.ListNotifications(alice, &pb.NotificationsRequest{UnreadOnly: true})
+	if err != nil || len(notifications.Notifications) != 0 {
+		t.Errorf("ListNotifications after marking them read = %v, %v, want none", notifications, err)
+	}
+
+	// A new deadline that passes again runs the automation again
+	late.Deadline = fake.Now().Add(time.Hour).Unix()
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: late}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	fake.Advance(2 * time.Hour)
+	if runs, err := testServer.RunOverdueAutomations(ctx); err != nil || runs != 1 {
+		t.Errorf("RunOverdueAutomations after rescheduling = %d, %v, want 1 run", runs, err)
+	}
+}
+
+func TestCreateAutomation_Invalid(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	for _, tc := range []struct {
+		automation *pb.Automation
+		want       map[string]string
+	}{
+		{&pb.Automation{}, map[string]string{"trigger": "set a trigger", "actions": "add at least one action"}},
+		{&pb.Automation{Trigger: pb.Trigger_WHEN_CREATED, Actions: []*pb.AutomationAction{
+			{Type: pb.ActionType_CREATE_TASK, Task: &pb.Task{}},
+			{Type: pb.ActionType_SET_PRIORITY},
+			{Type: pb.ActionType_ADD_TAGS, Tags: []string{"#"}},
+			{},
+		}}, map[string]string{
+			"actions[0].task":     "the task to create has no title",
+			"actions[1].priority": "set a priority",
+			"actions[2].tags":     "add at least one tag",
+			"actions[3].type":     "set an action type",
+		}},
+		{&pb.Automation{Trigger: pb.Trigger_WHEN_DELETED, Actions: []*pb.AutomationAction{{Type: pb.ActionType_RAISE_PRIORITY}}}, map[string]string{
+			"actions[0].type": "tasks in the trash can't be changed, create a task or notify instead",
+		}},
+	} {
+		_, err := testServer.CreateAutomation(context.Background(), &pb.AutomationRequest{Automation: tc.automation})
+		if diff := cmp.Diff(tc.want, validators.FieldViolations(err)); diff != "" {
+			t.Errorf("CreateAutomation(%v) (-want,+got):%v", tc.automation, diff)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+)
+
+// MaxBatchSize is the largest number of tasks accepted by a batch RPC.
+const MaxBatchSize = 500
+
+// batchItemFunc applies one batch item inside the transaction and returns the
+// stored task, nil for deletes.
+type batchItemFunc func(ctx context.Context, tx *txn, task *pb.Task) (*pb.Task, error)
+
+// BatchCreateTasks creates all the tasks in a single transaction
+func (s *Server) BatchCreateTasks(ctx context.Context, in *pb.BatchTaskRequest) (*pb.BatchTaskResponse, error) {
+	return s.runBatch(ctx, in, s.createTask)
+}
+
+// BatchUpdateTasks updates all the tasks in a single transaction, each task must carry its version
+func (s *Server) BatchUpdateTasks(ctx context.Context, in *pb.BatchTaskRequest) (*pb.BatchTaskResponse, error) {
+	return s.runBatch(ctx, in, s.updateTask)
+}
+
+// BatchDeleteTasks moves all the tasks to the trash in a single transaction
+func (s *Server) BatchDeleteTasks(ctx context.Context, in *pb.BatchTaskRequest) (*pb.BatchTaskResponse, error) {
+	return s.runBatch(ctx, in, func(ctx context.Context, tx *txn, task *pb.Task) (*pb.Task, error) {
+		deleted, err := s.deleteTask(ctx, tx, task)
+		if err == nil && !deleted {
+			err = status.Errorf(codes.NotFound, "task %d does not exist or is already in the trash", task.TaskId)
+		}
+		return nil, err
+	})
+}
+
+// runBatch applies apply to every task of the request in one transaction.
+// By default the first failure rolls back the whole batch. In best-effort mode
+// each item runs in its own savepoint, so only the failed items are undone.
+func (s *Server) runBatch(ctx context.Context, in *pb.BatchTaskRequest, apply batchItemFunc) (*pb.BatchTaskResponse, error) {
+	if len(in.GetTasks()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "the batch is empty")
+	}
+	if len(in.Tasks) > MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "the batch has %d tasks, at most %d are allowed", len(in.Tasks), MaxBatchSize)
+	}
+
+	results := make([]*pb.BatchTaskResult, len(in.Tasks))
+	for i := range results {
+		results[i] = &pb.BatchTaskResult{Index: int32(i)}
+	}
+
+	failed := -1
+	err := s.inTx(func(tx *txn) error {
+		for i, task := range in.Tasks {
+			var stored *pb.Task
+			var err error
+			switch {
+			case task == nil:
+				err = status.Error(codes.InvalidArgument, "Task is nil")
+			case in.BestEffort:
+				stored, err = applyInSavepoint(ctx, tx, task, apply)
+			default:
+				stored, err = apply(ctx, tx, task)
+			}
+			if err == nil {
+				results[i].Success, results[i].Task = true, stored
+				continue
+			}
+			setBatchError(results[i], err)
+			if !in.BestEffort {
+				failed = i
+				return err
+			}
+		}
+		return nil
+	})
+
+	if failed >= 0 {
+		for i, result := range results {
+			switch {
+			case i < failed:
+				result.Success, result.Task = false, nil
+				setBatchError(result, status.Errorf(codes.Aborted, "rolled back because item %d failed", failed))
+			case i > failed:
+				setBatchError(result, status.Errorf(codes.Aborted, "not attempted because item %d failed", failed))
+			}
+		}
+		return &pb.BatchTaskResponse{Results: results}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	committed := false
+	for _, result := range results {
+		committed = committed || result.Success
+	}
+	return &pb.BatchTaskResponse{Results: results, Committed: committed}, nil
+}
+
+// applyInSavepoint runs one item so that its failure only rolls back its own changes.
+func applyInSavepoint(ctx context.Context, tx *txn, task *pb.Task, apply batchItemFunc) (*pb.Task, error) {
+	if _, err := tx.Exec("SAVEPOINT batch_item"); err != nil {
+		return nil, status.Errorf(codes.Internal, "creating savepoint: %v", err)
+	}
+	queued := len(tx.events)
+	stored, err := apply(ctx, tx, task)
+	if err != nil {
+		tx.events = tx.events[:queued] // The rolled back changes never happened
+		if _, rollbackErr := tx.Exec("ROLLBACK TO batch_item; RELEASE batch_item"); rollbackErr != nil {
+			return nil, status.Errorf(codes.Internal, "rolling back to savepoint: %v", rollbackErr)
+		}
+		return nil, err
+	}
+	if _, err := tx.Exec("RELEASE batch_item"); err != nil {
+		return nil, status.Errorf(codes.Internal, "releasing savepoint: %v", err)
+	}
+	return stored, nil
+}
+
+// setBatchError marks a result as failed with err.
+func setBatchError(result *pb.BatchTaskResult, err error) {
+	st := status.Convert(err)
+	result.Success = false
+	result.Code = int32(st.Code())
+	result.Error = st.Message()
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "taskify/backend/proto"
+
+	"google.golang.org/grpc/codes"
+)
+
+func batchTasks(n int) []*pb.Task {
+	var tasks []*pb.Task
+	for i := 0; i < n; i++ {
+		tasks = append(tasks, &pb.Task{
+			Title:        fmt.Sprintf("Batch Task %d", i),
+			Description:  "This is the task",
+			Deadline:     time.Now().Add(1 * time.Hour).Unix(),
+			ExitCriteria: "Finish it",
+		})
+	}
+	return tasks
+}
+
+func countTasks(t *testing.T, s *Server) int {
+	t.Helper()
+	res, err := s.ListTasks(context.Background(), &pb.TaskRequest{Task: &pb.Task{}})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	return len(res.Tasks)
+}
+
+func TestBatchCreateTasks(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	res, err := testServer.BatchCreateTasks(ctx, &pb.BatchTaskRequest{Tasks: batchTasks(3)})
+	if err != nil {
+		t.Fatalf("BatchCreateTasks: %v", err)
+	}
+	if !res.Committed {
+		t.Error("BatchCreateTasks did not commit")
+	}
+	for _, result := range res.Results {
+		if !result.Success || result.Task.GetTaskId() == 0 {
+			t.Errorf("Item %d was not created: %s", result.Index, result.Error)
+		}
+	}
+	if got := countTasks(t, &testServer); got != 3 {
+		t.Errorf("Stored %d tasks, want 3", got)
+	}
+}
+
+func TestBatchCreateTasks_All_Or_Nothing(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	tasks := batchTasks(3)
+	tasks[1].Title = ""
+	res, err := testServer.BatchCreateTasks(ctx, &pb.BatchTaskRequest{Tasks: tasks})
+	if err != nil {
+		t.Fatalf("BatchCreateTasks: %v", err)
+	}
+	if res.Committed {
+		t.Error("A failed all-or-nothing batch must not commit")
+	}
+	wantCodes := []codes.Code{codes.Aborted, codes.InvalidArgument, codes.Aborted}
+	for i, result := range res.Results {
+		if result.Success || codes.Code(result.Code) != wantCodes[i] {
+			t.Errorf("Item %d: success %v code %v, want failure with %v", i, result.Success, codes.Code(result.Code), wantCodes[i])
+		}
+	}
+	if got := countTasks(t, &testServer); got != 0 {
+		t.Errorf("Stored %d tasks after a rolled back batch, want 0", got)
+	}
+}
+
+func TestBatchCreateTasks_Best_Effort(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	tasks := batchTasks(3)
+	tasks[2] = tasks[0] // Duplicate, violates the UNIQUE index
+	res, err := testServer.BatchCreateTasks(ctx, &pb.BatchTaskRequest{Tasks: tasks, BestEffort: true})
+	if err != nil {
+		t.Fatalf("BatchCreateTasks: %v", err)
+	}
+	if !res.Committed {
+		t.Error("BatchCreateTasks did not commit the successful items")
+	}
+	if !res.Results[0].Success || !res.Results[1].Success || res.Results[2].Success {
+		t.Errorf("Unexpected results %v", res.Results)
+	}
+	if got := countTasks(t, &testServer); got != 2 {
+		t.Errorf("Stored %d tasks, want 2", got)
+	}
+}
+
+func TestBatchUpdate_And_Delete_Tasks(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	created, err := testServer.BatchCreateTasks(ctx, &pb.BatchTaskRequest{Tasks: batchTasks(2)})
+	if err != nil {
+		t.Fatalf("BatchCreateTasks: %v", err)
+	}
+	var tasks []*pb.Task
+	for _, result := range created.Results {
+		result.Task.Complete = true
+		tasks = append(tasks, result.Task)
+	}
+
+	updated, err := testServer.BatchUpdateTasks(ctx, &pb.BatchTaskRequest{Tasks: tasks})
+	if err != nil {
+		t.Fatalf("BatchUpdateTasks: %v", err)
+	}
+	for _, result := range updated.Results {
+		if !result.Success || !result.Task.Complete {
+			t.Errorf("Item %d was not updated: %s", result.Index, result.Error)
+		}
+	}
+
+	deleted, err := testServer.BatchDeleteTasks(ctx, &pb.BatchTaskRequest{Tasks: []*pb.Task{{TaskId: tasks[0].TaskId}, {TaskId: 99}}, BestEffort: true})
+	if err != nil {
+		t.Fatalf("BatchDeleteTasks: %v", err)
+	}
+	if !deleted.Results[0].Success || codes.Code(deleted.Results[1].Code) != codes.NotFound {
+		t.Errorf("Unexpected delete results %v", deleted.Results)
+	}
+	if got := countTasks(t, &testServer); got != 1 {
+		t.Errorf("%d tasks left, want 1", got)
+	}
+}
+
+func TestBatch_Too_Large(t *testing.T) {
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	if _, err := testServer.BatchCreateTasks(context.Background(), &pb.BatchTaskRequest{Tasks: batchTasks(MaxBatchSize + 1)}); err == nil {
+		t.Error("A batch over MaxBatchSize should be rejected")
+	}
+}
@@ -0,0 +1,291 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+const categoryRuleColumns = "ruleId, name, fields, keyword, pattern, addTags, priority, list, assignee, active, createdAt"
+
+// ruleFields are the task fields a rule can match, in the order they are tried.
+var ruleFields = []string{"title", "description"}
+
+// scanCategoryRule reads a row selected with categoryRuleColumns.
+func scanCategoryRule(row rowScanner) (*pb.CategoryRule, error) {
+	rule := &pb.CategoryRule{}
+	var fields, addTags string
+	err := row.Scan(&rule.RuleId, &rule.Name, &fields, &rule.Keyword, &rule.Pattern, &addTags, &rule.Priority, &rule.List, &rule.Assignee, &rule.Active, &rule.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	rule.Fields, rule.AddTags = strings.Fields(fields), strings.Fields(addTags)
+	return rule, nil
+}
+
+// normalizeCategoryRule trims a rule and reports every invalid field.
+func normalizeCategoryRule(rule *pb.CategoryRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	rule.Keyword = strings.TrimSpace(rule.Keyword)
+	rule.List = strings.TrimSpace(rule.List)
+	rule.Assignee = strings.TrimSpace(rule.Assignee)
+	rule.AddTags = normalizeTags(rule.AddTags)
+
+	violations := &validators.Violations{}
+	for i, field := range rule.Fields {
+		rule.Fields[i] = strings.TrimSpace(field)
+		if !slices.Contains(ruleFields, rule.Fields[i]) {
+			violations.Add("fields", fmt.Sprintf("unknown field %q, rules match title and description", field))
+		}
+	}
+	switch {
+	case rule.Keyword == "" && rule.Pattern == "":
+		violations.Add("keyword", "set a keyword or a pattern")
+	case rule.Keyword != "" && rule.Pattern != "":
+		violations.Add("pattern", "set a keyword or a pattern, not both")
+	case rule.Keyword != "" && normalizeText(rule.Keyword) == "":
+		violations.Add("keyword", "keyword has no letters or digits")
+	case rule.Pattern != "":
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			violations.Add("pattern", fmt.Sprintf("invalid pattern: %v", err))
+		}
+	}
+	if _, ok := pb.Priority_name[int32(rule.Priority)]; !ok {
+		violations.Add("priority", fmt.Sprintf("unknown priority %d", rule.Priority))
+	}
+	if len(rule.AddTags) == 0 && rule.Priority == pb.Priority_PRIORITY_UNSPECIFIED && rule.List == "" && rule.Assignee == "" {
+		violations.Add("addTags", "the rule changes nothing, set tags, a priority, a list or an assignee")
+	}
+	return violations.Err()
+}
+
+func (s *Server) getCategoryRule(id int64) (*pb.CategoryRule, error) {
+	rule, err := scanCategoryRule(s.Db.QueryRow("SELECT "+categoryRuleColumns+" FROM category_rules WHERE ruleId = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "category rule %d not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading category rule %d: %v", id, err)
+	}
+	return rule, nil
+}
+
+// CreateCategoryRule adds an active rule after the existing ones.
+func (s *Server) CreateCategoryRule(ctx context.Context, in *pb.CategoryRuleRequest) (*pb.CategoryRuleResponse, error) {
+	if in == nil || in.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "Rule is nil")
+	}
+	rule := proto.Clone(in.Rule).(*pb.CategoryRule)
+	if err := normalizeCategoryRule(rule); err != nil {
+		return nil, err
+	}
+
+	query := `INSERT INTO category_rules (name, fields, keyword, pattern, addTags, priority, list, assignee, active, createdAt)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, 1, ?)`
+	res, err := s.Db.Exec(query, rule.Name, strings.Join(rule.Fields, " "), rule.Keyword, rule.Pattern, strings.Join(rule.AddTags, " "),
+		rule.Priority, rule.List, rule.Assignee, s.now().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating category rule: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating category rule: %v", err)
+	}
+	rule, err = s.getCategoryRule(id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CategoryRuleResponse{Rule: rule}, nil
+}
+
+// UpdateCategoryRule replaces every field of a rule but its creation time, and
+// its active flag when the request sets it.
+func (s *Server) UpdateCategoryRule(ctx context.Context, in *pb.CategoryRuleRequest) (*pb.CategoryRuleResponse, error) {
+	if in == nil || in.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "Rule is nil")
+	}
+	if in.Rule.RuleId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "RuleId is empty")
+	}
+	rule := proto.Clone(in.Rule).(*pb.CategoryRule)
+	if err := normalizeCategoryRule(rule); err != nil {
+		return nil, err
+	}
+
+	query := `UPDATE category_rules SET name = ?, fields = ?, keyword = ?, pattern = ?, addTags = ?, priority = ?, list = ?, assignee = ?, active = COALESCE(?, active)
+		WHERE ruleId = ?`
+	res, err := s.Db.Exec(query, rule.Name, strings.Join(rule.Fields, " "), rule.Keyword, rule.Pattern, strings.Join(rule.AddTags, " "),
+		rule.Priority, rule.List, rule.Assignee, rule.Active, rule.RuleId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "updating category rule %d: %v", rule.RuleId, err)
+	}
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "category rule %d not found", rule.RuleId)
+	}
+	rule, err = s.getCategoryRule(rule.RuleId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CategoryRuleResponse{Rule: rule}, nil
+}
+
+// DeleteCategoryRule removes a rule, tasks it categorized keep their fields.
+func (s *Server) DeleteCategoryRule(ctx context.Context, in *pb.CategoryRuleRequest) (*pb.DeleteCategoryRuleResponse, error) {
+	if in == nil || in.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "Rule is nil")
+	}
+	res, err := s.Db.Exec("DELETE FROM category_rules WHERE ruleId = ?", in.Rule.RuleId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "deleting category rule %d: %v", in.Rule.RuleId, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "deleting category rule %d: %v", in.Rule.RuleId, err)
+	}
+	return &pb.DeleteCategoryRuleResponse{Success: rowsAffected > 0}, nil
+}
+
+// ListCategoryRules returns every rule in the order they run
+func (s *Server) ListCategoryRules(ctx context.Context, in *pb.CategoryRuleRequest) (*pb.ListCategoryRulesResponse, error) {
+	rules, err := categoryRules(s.Db, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListCategoryRulesResponse{Rules: rules}, nil
+}
+
+// categoryRules loads the rules in the order they run, only the active ones if activeOnly.
+func categoryRules(q queryer, activeOnly bool) ([]*pb.CategoryRule, error) {
+	query := "SELECT " + categoryRuleColumns + " FROM category_rules"
+	if activeOnly {
+		query += " WHERE active = 1"
+	}
+	rows, err := q.Query(query + " ORDER BY ruleId ASC")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the category rules: %v", err)
+	}
+	defer rows.Close()
+
+	var rules []*pb.CategoryRule
+	for rows.Next() {
+		rule, err := scanCategoryRule(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reading a category rule: %v", err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the category rules: %v", err)
+	}
+	return rules, nil
+}
+
+// CategorizeTask is a dry run of the rules for a task: it returns the task as
+// the rules would leave it and the rules that fired, without storing anything.
+func (s *Server) CategorizeTask(ctx context.Context, in *pb.CategorizeRequest) (*pb.CategorizeResponse, error) {
+	if in == nil || in.Task == nil {
+		return nil, status.Error(codes.InvalidArgument, "Task is nil")
+	}
+	rules, err := categoryRules(s.Db, true)
+	if err != nil {
+		return nil, err
+	}
+	if in.Rule != nil {
+		rule := proto.Clone(in.Rule).(*pb.CategoryRule)
+		if err := normalizeCategoryRule(rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	task := proto.Clone(in.Task).(*pb.Task)
+	matches := categorize(task, rules)
+	task.Tags = normalizeTags(task.Tags)
+	return &pb.CategorizeResponse{Task: task, Matches: matches}, nil
+}
+
+// applyCategoryRules runs the active rules on a task about to be created.
+func applyCategoryRules(q queryer, task *pb.Task) error {
+	rules, err := categoryRules(q, true)
+	if err != nil {
+		return err
+	}
+	categorize(task, rules)
+	return nil
+}
+
+// categorize applies the rules that match task in order, filling in the
+// fields the task does not have yet, and returns what each rule changed.
+func categorize(task *pb.Task, rules []*pb.CategoryRule) []*pb.RuleMatch {
+	var matches []*pb.RuleMatch
+	for _, rule := range rules {
+		field, matched, ok := matchRule(rule, task)
+		if !ok {
+			continue
+		}
+		match := &pb.RuleMatch{Rule: rule, Field: field, Matched: matched}
+		tags := normalizeTags(task.Tags)
+		for _, tag := range rule.AddTags {
+			if !slices.Contains(tags, tag) {
+				task.Tags = append(task.Tags, tag)
+				tags = append(tags, tag)
+				match.Changes = append(match.Changes, "tag "+tag)
+			}
+		}
+		if task.Priority == pb.Priority_PRIORITY_UNSPECIFIED && rule.Priority != pb.Priority_PRIORITY_UNSPECIFIED {
+			task.Priority = rule.Priority
+			match.Changes = append(match.Changes, "priority "+rule.Priority.String())
+		}
+		if strings.TrimSpace(task.List) == "" && rule.List != "" {
+			task.List = rule.List
+			match.Changes = append(match.Changes, "list "+rule.List)
+		}
+		if strings.TrimSpace(task.Assignee) == "" && rule.Assignee != "" {
+			task.Assignee = rule.Assignee
+			match.Changes = append(match.Changes, "assignee "+rule.Assignee)
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
+// matchRule returns the first field of task the rule matches and the text
+// that matched. Rules were validated when stored, a pattern that no longer
+// compiles matches nothing.
+func matchRule(rule *pb.CategoryRule, task *pb.Task) (string, string, bool) {
+	var pattern *regexp.Regexp
+	if rule.Pattern != "" {
+		var err error
+		if pattern, err = regexp.Compile(rule.Pattern); err != nil {
+			return "", "", false
+		}
+	}
+	keyword := " " + normalizeText(rule.Keyword) + " "
+	for _, field := range ruleFields {
+		if len(rule.Fields) > 0 && !slices.Contains(rule.Fields, field) {
+			continue
+		}
+		text := task.Title
+		if field == "description" {
+			text = task.Description
+		}
+		if pattern != nil {
+			if loc := pattern.FindStringIndex(text); loc != nil {
+				return field, text[loc[0]:loc[1]], true
+			}
+		} else if strings.Contains(" "+normalizeText(text)+" ", keyword) {
+			return field, rule.Keyword, true
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+func TestCategoryRules(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	createRule := func(rule *pb.CategoryRule) *pb.CategoryRule {
+		t.Helper()
+		res, err := testServer.CreateCategoryRule(ctx, &pb.CategoryRuleRequest{Rule: rule})
+		if err != nil {
+			t.Fatalf("CreateCategoryRule(%s): %v", rule.Name, err)
+		}
+		return res.Rule
+	}
+	security := createRule(&pb.CategoryRule{Name: "Security", Keyword: "CVE", AddTags: []string{"#Security"}, Priority: pb.Priority_URGENT, Assignee: "sec-team"})
+	createRule(&pb.CategoryRule{Name: "Releases", Fields: []string{"title"}, Pattern: `(?i)^release v[0-9.]+`, AddTags: []string{"release"}, List: "releases", Priority: pb.Priority_HIGH})
+
+	res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+		Title: "Release v2.4", Description: "Ship the fix for CVE-2030-1234", ExitCriteria: "Tagged",
+		Deadline: time.Now().Add(24 * time.Hour).Unix(), Priority: pb.Priority_LOW,
+	}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	want := &pb.Task{
+		Title: "Release v2.4", Description: "Ship the fix for CVE-2030-1234", ExitCriteria: "Tagged",
+		Tags: []string{"release", "security"}, Priority: pb.Priority_LOW, Assignee: "sec-team", List: "releases",
+	}
+	if diff := cmp.Diff(want, res.Task, cmpopts.IgnoreFields(pb.Task{}, "TaskId", "Deadline", "DeadlineTime", "Version", "UpdateTime", "CreatedAt"), cmpopts.IgnoreUnexported(pb.Task{})); diff != "" {
+		t.Errorf("Categorized task (-want,+got):%v", diff)
+	}
+
+	// The dry run tries an unsaved rule after the stored ones and stores nothing
+	dryRun, err := testServer.CategorizeTask(ctx, &pb.CategorizeRequest{
+		Task: &pb.Task{Title: "Patch CVE in the login page"},
+		Rule: &pb.CategoryRule{Name: "Login", Keyword: "login page", AddTags: []string{"auth"}, Priority: pb.Priority_LOW},
+	})
+	if err != nil {
+		t.Fatalf("CategorizeTask: %v", err)
+	}
+	var fired [][]string
+	for _, match := range dryRun.Matches {
+		fired = append(fired, append([]string{match.Rule.Name, match.Field, match.Matched}, match.Changes...))
+	}
+	wantFired := [][]string{
+		{"Security", "title", "CVE", "tag security", "priority URGENT", "assignee sec-team"},
+		{"Login", "title", "login page", "tag auth"},
+	}
+	if diff := cmp.Diff(wantFired, fired); diff != "" {
+		t.Errorf("Matches (-want,+got):%v", diff)
+	}
+	if !cmp.Equal(dryRun.Task.Tags, []string{"auth", "security"}) {
+		t.Errorf("Dry run tags = %v, want auth and security", dryRun.Task.Tags)
+	}
+
+	// An update that leaves out the active flag keeps it
+	security.Name, security.Active = "Security fixes", nil
+	updated, err := testServer.UpdateCategoryRule(ctx, &pb.CategoryRuleRequest{Rule: security})
+	if err != nil {
+		t.Fatalf("UpdateCategoryRule: %v", err)
+	}
+	if !updated.Rule.GetActive() {
+		t.Errorf("UpdateCategoryRule without active = %v, want the rule still active", updated.Rule)
+	}
+	dryRun, err = testServer.CategorizeTask(ctx, &pb.CategorizeRequest{Task: &pb.Task{Title: "Patch CVE"}})
+	if err != nil || len(dryRun.Matches) != 1 {
+		t.Errorf("CategorizeTask after the update = %v, %v, want the rule to fire", dryRun, err)
+	}
+
+	security.Active = proto.Bool(false)
+	if _, err := testServer.UpdateCategoryRule(ctx, &pb.CategoryRuleRequest{Rule: security}); err != nil {
+		t.Fatalf("UpdateCategoryRule: %v", err)
+	}
+	dryRun, err = testServer.CategorizeTask(ctx, &pb.CategorizeRequest{Task: &pb.Task{Title: "Patch CVE"}})
+	if err != nil || len(dryRun.Matches) != 0 {
+		t.Errorf("CategorizeTask with the rule inactive = %v, %v, want no match", dryRun, err)
+	}
+
+	deleted, err := testServer.DeleteCategoryRule(ctx, &pb.CategoryRuleRequest{Rule: security})
+	if err != nil || !deleted.Success {
+		t.Errorf("DeleteCategoryRule = %v, %v", deleted, err)
+	}
+	rules, err := testServer.ListCategoryRules(ctx, &pb.CategoryRuleRequest{})
+	if err != nil || len(rules.Rules) != 1 || rules.Rules[0].Name != "Releases" {
+		t.Errorf("ListCategoryRules = %v, %v, want only the release rule", rules, err)
+	}
+}
+
+func TestCreateCategoryRule_Invalid(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	for _, tc := range []struct {
+		rule *pb.CategoryRule
+		want map[string]string
+	}{
+		{&pb.CategoryRule{AddTags: []string{"a"}}, map[string]string{"keyword": "set a keyword or a pattern"}},
+		{&pb.CategoryRule{Keyword: "a", Pattern: "a", List: "l"}, map[string]string{"pattern": "set a keyword or a pattern, not both"}},
+		{&pb.CategoryRule{Pattern: "(", List: "l"}, map[string]string{"pattern": "invalid pattern: error parsing regexp: missing closing ): `(`"}},
+		{&pb.CategoryRule{Keyword: "a", Fields: []string{"exitCriteria"}}, map[string]string{
+			"fields":  `unknown field "exitCriteria", rules match title and description`,
+			"addTags": "the rule changes nothing, set tags, a priority, a list or an assignee",
+		}},
+	} {
+		_, err := testServer.CreateCategoryRule(context.Background(), &pb.CategoryRuleRequest{Rule: tc.rule})
+		if diff := cmp.Diff(tc.want, validators.FieldViolations(err)); diff != "" {
+			t.Errorf("CreateCategoryRule(%v) (-want,+got):%v", tc.rule, diff)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// DeadlineDateLayout is the format of all-day deadlines.
+const DeadlineDateLayout = "2006-01-02"
+
+// EndOfDay returns the last second of date in loc, the deadline of an all-day task.
+func EndOfDay(date string, loc *time.Location) (int64, error) {
+	day, err := time.ParseInLocation(DeadlineDateLayout, date, loc)
+	if err != nil {
+		return 0, err
+	}
+	return day.AddDate(0, 0, 1).Unix() - 1, nil
+}
+
+// normalizeDeadline reconciles the three ways a client can give a deadline.
+// An all-day date wins and is resolved in loc, otherwise deadlineTime fills in
+// a missing legacy deadline. When both are set and disagree, the one that
+// differs from the stored deadline is the edit, stored is 0 for new tasks.
+func normalizeDeadline(task *pb.Task, stored int64, loc *time.Location) error {
+	switch {
+	case task.DeadlineDate != "":
+		deadline, err := EndOfDay(task.DeadlineDate, loc)
+		if err != nil {
+			return validators.FieldError("deadlineDate", "deadline date must look like 2006-01-02")
+		}
+		task.Deadline = deadline
+	case task.DeadlineTime != nil:
+		if err := task.DeadlineTime.CheckValid(); err != nil {
+			return validators.FieldError("deadlineTime", "deadline time is invalid")
+		}
+		seconds := task.DeadlineTime.Seconds
+		switch {
+		case task.Deadline == 0, task.Deadline == stored && seconds != stored:
+			task.Deadline = seconds
+		case task.Deadline != seconds && seconds != stored:
+			return validators.FieldError("deadlineTime", "deadline and deadline time disagree")
+		}
+	}
+	setDeadlineTime(task)
+	return nil
+}
+
+// setDeadlineTime fills deadlineTime from the legacy deadline.
+func setDeadlineTime(task *pb.Task) {
+	task.DeadlineTime = nil
+	if task.Deadline != 0 {
+		task.DeadlineTime = timestamppb.New(time.Unix(task.Deadline, 0))
+	}
+}
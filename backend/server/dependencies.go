@@ -0,0 +1,116 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// parseTaskIds reads the ids of the dependsOn column, separated by spaces.
+func parseTaskIds(text string) ([]int64, error) {
+	var ids []int64
+	for _, field := range strings.Fields(text) {
+		id, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("reading task id %q: %w", field, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// formatTaskIds writes ids the way the dependsOn column stores them.
+func formatTaskIds(ids []int64) string {
+	fields := make([]string, len(ids))
+	for i, id := range ids {
+		fields[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(fields, " ")
+}
+
+// normalizeTaskIds sorts ids without duplicates, nil if there are none.
+func normalizeTaskIds(ids []int64) []int64 {
+	if len(ids) == 0 {
+		return nil
+	}
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// validateDependencies checks that the tasks a task newly depends on exist and
+// do not already depend on it, directly or through other tasks. Dependencies
+// that were already stored are kept even if they have since been trashed.
+func validateDependencies(tx *txn, task, before *pb.Task) error {
+	var added []int64
+	for _, id := range task.DependsOn {
+		if !slices.Contains(before.GetDependsOn(), id) {
+			added = append(added, id)
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+	for _, id := range added {
+		dependency, err := getTask(tx, id)
+		if status.Code(err) == codes.NotFound || (err == nil && dependency.DeletedAt != 0) {
+			return validators.FieldError("dependsOn", fmt.Sprintf("task %d does not exist", id))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if task.TaskId == 0 {
+		return nil // Nothing depends on a new task yet
+	}
+
+	graph, err := dependencyGraph(tx)
+	if err != nil {
+		return err
+	}
+	seen := map[int64]bool{}
+	for pending := added; len(pending) > 0; {
+		id := pending[0]
+		pending = pending[1:]
+		if id == task.TaskId {
+			return validators.FieldError("dependsOn", "the dependencies would form a cycle")
+		}
+		if !seen[id] {
+			seen[id] = true
+			pending = append(pending, graph[id]...)
+		}
+	}
+	return nil
+}
+
+// dependencyGraph loads the tasks each task depends on, for the tasks that have any.
+func dependencyGraph(q queryer) (map[int64][]int64, error) {
+	rows, err := q.Query("SELECT taskId, dependsOn FROM tasks WHERE dependsOn <> ''")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the dependencies: %v", err)
+	}
+	defer rows.Close()
+
+	graph := map[int64][]int64{}
+	for rows.Next() {
+		var id int64
+		var dependsOn string
+		if err := rows.Scan(&id, &dependsOn); err != nil {
+			return nil, status.Errorf(codes.Internal, "reading the dependencies: %v", err)
+		}
+		if graph[id], err = parseTaskIds(dependsOn); err != nil {
+			return nil, status.Errorf(codes.Internal, "reading the dependencies of task %d: %v", id, err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the dependencies: %v", err)
+	}
+	return graph, nil
+}
@@ -0,0 +1,362 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// DefaultDuplicateThreshold is the least similarity of two titles for the
+// tasks to count as duplicates, when no threshold is given.
+const DefaultDuplicateThreshold = 0.75
+
+// maxDuplicateCandidates is the most duplicates reported for one task.
+const maxDuplicateCandidates = 10
+
+// duplicateReason is the ErrorInfo reason of the AlreadyExists error of a
+// task that has exact duplicates.
+const duplicateReason = "DUPLICATE_TASK"
+
+// normalizeText lower-cases text and keeps only its letters and digits,
+// separated by single spaces, so "Fix login-bug!" reads "fix login bug".
+func normalizeText(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	}), " ")
+}
+
+// similarity compares two normalized titles from 0 to 1: the best of their
+// edit distance, which catches typos, and of their shared words, which
+// catches the same words in another order.
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	edits := 1 - float64(levenshtein(ra, rb))/float64(max(len(ra), len(rb)))
+
+	wordsA, wordsB := strings.Fields(a), strings.Fields(b)
+	shared := 0
+	for _, word := range wordsA {
+		if slices.Contains(wordsB, word) {
+			shared++
+		}
+	}
+	words := float64(shared) / float64(len(wordsA)+len(wordsB)-shared)
+	return max(edits, words)
+}
+
+// levenshtein counts the insertions, deletions and substitutions that turn a into b.
+func levenshtein(a, b []rune) int {
+	previous, current := make([]int, len(b)+1), make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := range a {
+		current[0] = i + 1
+		for j := range b {
+			cost := 1
+			if a[i] == b[j] {
+				cost = 0
+			}
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// sameText reports whether two tasks only differ by case, spaces and
+// punctuation, with the same deadline, like the UNIQUE index once normalized.
+func sameText(a, b *pb.Task) bool {
+	return normalizeText(a.Title) == normalizeText(b.Title) &&
+		normalizeText(a.Description) == normalizeText(b.Description) &&
+		normalizeText(a.ExitCriteria) == normalizeText(b.ExitCriteria) &&
+		a.Deadline == b.Deadline
+}
+
+// openTasks loads the tasks that are neither complete nor in the trash, of list if set.
+func openTasks(q queryer, list string) ([]*pb.Task, error) {
+	query, args := "SELECT "+taskColumns+" FROM tasks WHERE deletedAt = 0 AND complete = 0", []any{}
+	if list != "" {
+		query += " AND list = ?"
+		args = append(args, list)
+	}
+	return queryTasks(q, query+" ORDER BY taskId", args...)
+}
+
+// duplicateCandidates returns the tasks of others whose title is at least
+// threshold similar to the title of task, most similar first.
+func duplicateCandidates(task *pb.Task, others []*pb.Task, threshold float64) []*pb.DuplicateCandidate {
+	title := normalizeText(task.Title)
+	var candidates []*pb.DuplicateCandidate
+	for _, other := range others {
+		if other.TaskId == task.TaskId && task.TaskId != 0 {
+			continue
+		}
+		exact := sameText(task, other)
+		if score := similarity(title, normalizeText(other.Title)); exact || score >= threshold {
+			candidates = append(candidates, &pb.DuplicateCandidate{Task: other, Similarity: score, Exact: exact})
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b *pb.DuplicateCandidate) int {
+		if a.Exact != b.Exact {
+			if a.Exact {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(b.Similarity, a.Similarity)
+	})
+	if len(candidates) > maxDuplicateCandidates {
+		candidates = candidates[:maxDuplicateCandidates]
+	}
+	return candidates
+}
+
+// checkDuplicates finds the open tasks a newly created task looks like. Exact
+// duplicates fail with AlreadyExists unless allowed, naming them in an
+// ErrorInfo so clients can offer to open them instead.
+func checkDuplicates(tx *txn, task *pb.Task, allow bool) ([]*pb.DuplicateCandidate, error) {
+	open, err := openTasks(tx, "")
+	if err != nil {
+		return nil, err
+	}
+	candidates := duplicateCandidates(task, open, DefaultDuplicateThreshold)
+	var exact []string
+	for _, candidate := range candidates {
+		if candidate.Exact {
+			exact = append(exact, strconv.FormatInt(candidate.Task.TaskId, 10))
+		}
+	}
+	if len(exact) == 0 || allow {
+		return candidates, nil
+	}
+	st := status.Newf(codes.AlreadyExists, "the task is a duplicate of task %s, allow duplicates to create it anyway", strings.Join(exact, ", "))
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: duplicateReason, Domain: "taskify", Metadata: map[string]string{"taskIds": strings.Join(exact, " ")}})
+	if err != nil {
+		return nil, st.Err()
+	}
+	return nil, detailed.Err()
+}
+
+// DuplicateTaskIds returns the tasks named by the AlreadyExists error of a
+// duplicate task, nil for any other error.
+func DuplicateTaskIds(err error) []int64 {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == duplicateReason {
+			ids, _ := parseTaskIds(info.Metadata["taskIds"])
+			return ids
+		}
+	}
+	return nil
+}
+
+// FindDuplicates finds the open tasks that look like a stored task or a task
+// about to be created. Without either, it groups every open task with the
+// tasks it looks like, comparing each pair.
+func (s *Server) FindDuplicates(ctx context.Context, in *pb.DuplicatesRequest) (*pb.DuplicatesResponse, error) {
+	threshold := in.GetThreshold()
+	switch {
+	case threshold < 0 || threshold > 1:
+		return nil, validators.FieldError("threshold", "threshold is not between 0 and 1")
+	case threshold == 0:
+		threshold = DefaultDuplicateThreshold
+	}
+	open, err := openTasks(s.Db, strings.TrimSpace(in.GetList()))
+	if err != nil {
+		return nil, err
+	}
+
+	task := in.GetTask()
+	if in.GetTaskId() != 0 {
+		if task, err = getTask(s.Db, in.TaskId); err != nil {
+			return nil, err
+		}
+	}
+	if task != nil {
+		return &pb.DuplicatesResponse{Candidates: duplicateCandidates(task, open, threshold)}, nil
+	}
+
+	// Tasks linked by a similar pair end up in the same group
+	group := make([]int, len(open))
+	for i := range group {
+		group[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if group[i] != i {
+			group[i] = find(group[i])
+		}
+		return group[i]
+	}
+	titles := make([]string, len(open))
+	for i, task := range open {
+		titles[i] = normalizeText(task.Title)
+	}
+	for i := range open {
+		for j := i + 1; j < len(open); j++ {
+			if sameText(open[i], open[j]) || similarity(titles[i], titles[j]) >= threshold {
+				group[find(j)] = find(i)
+			}
+		}
+	}
+
+	members := map[int][]*pb.Task{}
+	var roots []int
+	for i, task := range open {
+		root := find(i)
+		if members[root] == nil {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], task)
+	}
+	response := &pb.DuplicatesResponse{}
+	for _, root := range roots {
+		if len(members[root]) > 1 {
+			response.Groups = append(response.Groups, &pb.DuplicateGroup{Tasks: members[root]})
+		}
+	}
+	return response, nil
+}
+
+// MergeTasks folds duplicates into the target task and moves them to the
+// trash, in one transaction. The target keeps its own fields and gains the
+// tags and dependencies of the sources, the highest priority, the deadline,
+// assignee and list of a source if it has none, and the descriptions and exit
+// criteria of the sources that differ from its own. Tasks depending on a
+// source depend on the target instead.
+func (s *Server) MergeTasks(ctx context.Context, in *pb.MergeRequest) (*pb.TaskResponse, error) {
+	if in.GetTargetId() == 0 {
+		return nil, validators.FieldError("targetId", "targetId is empty")
+	}
+	sourceIds := normalizeTaskIds(in.GetSourceIds())
+	if len(sourceIds) == 0 {
+		return nil, validators.FieldError("sourceIds", "there are no tasks to merge")
+	}
+	if slices.Contains(sourceIds, in.TargetId) {
+		return nil, validators.FieldError("sourceIds", "a task cannot be merged into itself")
+	}
+
+	var merged *pb.Task
+	err := s.inTx(func(tx *txn) error {
+		target, err := getTask(tx, in.TargetId)
+		if err != nil {
+			return err
+		}
+		if target.DeletedAt != 0 {
+			return status.Errorf(codes.NotFound, "task %d is in the trash", target.TaskId)
+		}
+		if in.Version != 0 && target.Version != in.Version {
+			return versionMismatch(target, in.Version)
+		}
+
+		merged = proto.Clone(target).(*pb.Task)
+		var sources []*pb.Task
+		for _, id := range sourceIds {
+			source, err := getTask(tx, id)
+			if err != nil {
+				return err
+			}
+			if source.DeletedAt != 0 {
+				return status.Errorf(codes.NotFound, "task %d is in the trash", id)
+			}
+			sources = append(sources, source)
+			mergeInto(merged, source, tx.now)
+		}
+		merged.DependsOn = slices.DeleteFunc(merged.DependsOn, func(id int64) bool {
+			return id == merged.TaskId || slices.Contains(sourceIds, id)
+		})
+		if !proto.Equal(merged, target) {
+			if merged, err = s.updateTask(ctx, tx, merged); err != nil {
+				return err
+			}
+		}
+
+		// Tasks waiting for a source now wait for the target
+		graph, err := dependencyGraph(tx)
+		if err != nil {
+			return err
+		}
+		for _, id := range slices.Sorted(maps.Keys(graph)) {
+			if id == merged.TaskId || slices.Contains(sourceIds, id) || !slices.ContainsFunc(graph[id], func(id int64) bool { return slices.Contains(sourceIds, id) }) {
+				continue
+			}
+			dependent, err := getTask(tx, id)
+			if err != nil {
+				return err
+			}
+			if dependent.DeletedAt != 0 {
+				continue
+			}
+			for i, dependency := range dependent.DependsOn {
+				if slices.Contains(sourceIds, dependency) {
+					dependent.DependsOn[i] = merged.TaskId
+				}
+			}
+			if _, err := s.updateTask(ctx, tx, dependent); err != nil {
+				return fmt.Errorf("moving the dependencies of task %d: %w", id, err)
+			}
+		}
+
+		for _, source := range sources {
+			if _, err := s.deleteTask(ctx, tx, source); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TaskResponse{Task: merged}, nil
+}
+
+// mergeInto adds what source has and target lacks to target. A deadline of
+// source that has passed at now is left out, the merged task couldn't be saved with it.
+func mergeInto(target, source *pb.Task, now time.Time) {
+	target.Tags = normalizeTags(append(target.Tags, source.Tags...))
+	target.DependsOn = normalizeTaskIds(append(target.DependsOn, source.DependsOn...))
+	target.Priority = max(target.Priority, source.Priority)
+	if target.Deadline == 0 && target.DeadlineDate == "" && source.Deadline >= now.Unix() {
+		target.Deadline, target.DeadlineDate, target.DeadlineTime = source.Deadline, source.DeadlineDate, source.DeadlineTime
+	}
+	if target.Assignee == "" {
+		target.Assignee = source.Assignee
+	}
+	if target.List == "" {
+		target.List = source.List
+	}
+	target.EstimateMinutes = max(target.EstimateMinutes, source.EstimateMinutes)
+	target.Description = appendDistinct(target.Description, source.Description)
+	target.ExitCriteria = appendDistinct(target.ExitCriteria, source.ExitCriteria)
+}
+
+// appendDistinct appends addition to text as a new paragraph, unless text
+// already says the same once normalized.
+func appendDistinct(text, addition string) string {
+	switch {
+	case normalizeText(addition) == "" || strings.Contains(normalizeText(text), normalizeText(addition)):
+		return text
+	case strings.TrimSpace(text) == "":
+		return addition
+	}
+	return text + "\n\n" + addition
+}
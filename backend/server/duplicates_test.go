@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+func TestSimilarity(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want float64
+	}{
+		{"fix login bug", "fix login bug", 1},
+		{"fix login bug", "fix logn bug", 12.0 / 13},
+		{"fix login bug", "login bug fix", 1},
+		{"fix login bug", "write release notes", 4.0 / 19},
+		{"fix login bug", "", 0},
+	} {
+		if got := similarity(tc.a, tc.b); got < tc.want-1e-6 || got > tc.want+1e-6 {
+			t.Errorf("similarity(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestCreateTask_Duplicates(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	deadline := time.Now().Add(24 * time.Hour).Unix()
+	create := func(title string, allow bool) (*pb.TaskResponse, error) {
+		return testServer.CreateTask(ctx, &pb.TaskRequest{AllowDuplicates: allow, Task: &pb.Task{
+			Title: title, Description: "Users cannot sign in", ExitCriteria: "Fixed", Deadline: deadline,
+		}})
+	}
+
+	first, err := create("Fix login bug", false)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	_, err = create("fix  login bug!", false)
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("CreateTask of the same text = %v, want AlreadyExists", err)
+	}
+	if ids := DuplicateTaskIds(err); !cmp.Equal(ids, []int64{first.Task.TaskId}) {
+		t.Errorf("DuplicateTaskIds = %v, want %d", ids, first.Task.TaskId)
+	}
+
+	allowed, err := create("fix  login bug!", true)
+	if err != nil {
+		t.Fatalf("CreateTask allowing duplicates: %v", err)
+	}
+	if len(allowed.Duplicates) != 1 || !allowed.Duplicates[0].Exact {
+		t.Errorf("Duplicates = %v, want the first task as an exact duplicate", allowed.Duplicates)
+	}
+
+	similar, err := create("Fix the login bug", false)
+	if err != nil {
+		t.Fatalf("CreateTask of a similar title: %v", err)
+	}
+	if len(similar.Duplicates) != 2 || similar.Duplicates[0].Exact || similar.Duplicates[0].Similarity < DefaultDuplicateThreshold {
+		t.Errorf("Duplicates = %v, want both tasks as near duplicates", similar.Duplicates)
+	}
+}
+
+func TestFindDuplicatesAndMerge(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	create := func(task *pb.Task) *pb.Task {
+		t.Helper()
+		task.ExitCriteria = "Done"
+		if task.Deadline == 0 {
+			task.Deadline = time.Now().Add(48 * time.Hour).Unix()
+		}
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: task})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", task.Title, err)
+		}
+		return res.Task
+	}
+	target := create(&pb.Task{Title: "Update the onboarding docs", Description: "Add the VPN setup", Tags: []string{"docs"}})
+	source := create(&pb.Task{
+		Title: "Update onboarding docs", Description: "Mention the new laptop policy", Tags: []string{"onboarding"},
+		Priority: pb.Priority_HIGH, Assignee: "sam", Deadline: time.Now().Add(24 * time.Hour).Unix(),
+	})
+	other := create(&pb.Task{Title: "Renew the TLS certificate", Description: "Expires soon"})
+	dependent := create(&pb.Task{Title: "Announce the new docs", Description: "In the all-hands", DependsOn: []int64{source.TaskId}})
+
+	found, err := testServer.FindDuplicates(ctx, &pb.DuplicatesRequest{})
+	if err != nil {
+		t.Fatalf("FindDuplicates: %v", err)
+	}
+	if len(found.Groups) != 1 || len(found.Groups[0].Tasks) != 2 || found.Groups[0].Tasks[0].TaskId != target.TaskId {
+		t.Errorf("Groups = %v, want the two onboarding tasks", found.Groups)
+	}
+	found, err = testServer.FindDuplicates(ctx, &pb.DuplicatesRequest{TaskId: other.TaskId})
+	if err != nil {
+		t.Fatalf("FindDuplicates: %v", err)
+	}
+	if len(found.Candidates) != 0 {
+		t.Errorf("Candidates of a unique task = %v, want none", found.Candidates)
+	}
+
+	merged, err := testServer.MergeTasks(ctx, &pb.MergeRequest{TargetId: target.TaskId, SourceIds: []int64{source.TaskId}, Version: target.Version})
+	if err != nil {
+		t.Fatalf("MergeTasks: %v", err)
+	}
+	want := &pb.Task{
+		Title: target.Title, Description: "Add the VPN setup\n\nMention the new laptop policy", ExitCriteria: "Done",
+		Deadline: target.Deadline, Tags: []string{"docs", "onboarding"}, Priority: pb.Priority_HIGH, Assignee: "sam",
+	}
+	if diff := cmp.Diff(want, merged.Task, cmpopts.IgnoreFields(pb.Task{}, "TaskId", "DeadlineTime", "Version", "UpdateTime", "CreatedAt"), cmpopts.IgnoreUnexported(pb.Task{})); diff != "" {
+		t.Errorf("Merged task (-want,+got):%v", diff)
+	}
+	trashed, err := testServer.GetTask(ctx, source.TaskId)
+	if err != nil || trashed.DeletedAt == 0 {
+		t.Errorf("The source is not in the trash: %v, %v", trashed, err)
+	}
+	moved, err := testServer.GetTask(ctx, dependent.TaskId)
+	if err != nil || !cmp.Equal(moved.DependsOn, []int64{target.TaskId}) {
+		t.Errorf("The dependent task depends on %v, want the target: %v", moved.GetDependsOn(), err)
+	}
+
+	_, err = testServer.MergeTasks(ctx, &pb.MergeRequest{TargetId: target.TaskId, SourceIds: []int64{source.TaskId}})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Merging a trashed task = %v, want NotFound", err)
+	}
+}
+
+func TestMergeTasks_OverdueSource(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC))
+	config := validators.DefaultConfig()
+	config.Required = []string{"title", "description", "exitCriteria"}
+	validator, err := validators.New(config, validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}
+	var ids []int64
+	for _, task := range []*pb.Task{
+		{Title: "Book the venue", Description: "For the offsite", ExitCriteria: "Booked"},
+		{Title: "Book a venue", Description: "Near the office", ExitCriteria: "Booked", Deadline: fake.Now().Add(time.Hour).Unix()},
+	} {
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: task})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", task.Title, err)
+		}
+		ids = append(ids, res.Task.TaskId)
+	}
+
+	// The deadline of the source has passed, the target keeps having none
+	fake.Advance(2 * time.Hour)
+	merged, err := testServer.MergeTasks(ctx, &pb.MergeRequest{TargetId: ids[0], SourceIds: ids[1:]})
+	if err != nil {
+		t.Fatalf("MergeTasks: %v", err)
+	}
+	if merged.Task.Deadline != 0 || merged.Task.Description != "For the offsite\n\nNear the office" {
+		t.Errorf("Merged task = %v, want the description merged without the deadline", merged.Task)
+	}
+}
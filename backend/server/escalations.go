@@ -0,0 +1,413 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+const escalationPolicyColumns = "policyId, name, list, steps, active, createdAt"
+
+// scanEscalationPolicy reads a row selected with escalationPolicyColumns.
+func scanEscalationPolicy(row rowScanner) (*pb.EscalationPolicy, error) {
+	policy := &pb.EscalationPolicy{}
+	var steps string
+	if err := row.Scan(&policy.PolicyId, &policy.Name, &policy.List, &steps, &policy.Active, &policy.CreatedAt); err != nil {
+		return nil, err
+	}
+	stored := &pb.EscalationPolicy{}
+	if err := protojson.Unmarshal([]byte(steps), stored); err != nil {
+		return nil, err
+	}
+	policy.Steps = stored.Steps
+	return policy, nil
+}
+
+// marshalSteps encodes the steps of a policy for the steps column.
+func marshalSteps(policy *pb.EscalationPolicy) (string, error) {
+	data, err := protojson.Marshal(&pb.EscalationPolicy{Steps: policy.Steps})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "encoding escalation policy: %v", err)
+	}
+	return string(data), nil
+}
+
+// normalizeUsers trims user names and sorts them without duplicates.
+func normalizeUsers(users []string) []string {
+	var normalized []string
+	for _, user := range users {
+		if user = strings.TrimSpace(user); user != "" && !slices.Contains(normalized, user) {
+			normalized = append(normalized, user)
+		}
+	}
+	slices.Sort(normalized)
+	return normalized
+}
+
+// normalizeEscalationPolicy trims a policy and reports every invalid field,
+// including a list that already has another policy.
+func normalizeEscalationPolicy(q queryer, policy *pb.EscalationPolicy) error {
+	policy.Name = strings.TrimSpace(policy.Name)
+	policy.List = strings.TrimSpace(policy.List)
+
+	violations := &validators.Violations{}
+	var other int64
+	err := q.QueryRow("SELECT policyId FROM escalation_policies WHERE list = ? AND policyId != ?", policy.List, policy.PolicyId).Scan(&other)
+	switch {
+	case err == nil && policy.List == "":
+		violations.Add("list", fmt.Sprintf("policy %d already is the default policy", other))
+	case err == nil:
+		violations.Add("list", fmt.Sprintf("list %q already has policy %d", policy.List, other))
+	case err != sql.ErrNoRows:
+		return status.Errorf(codes.Internal, "reading the escalation policies: %v", err)
+	}
+	if len(policy.Steps) == 0 {
+		violations.Add("steps", "add at least one step")
+	}
+	for i, step := range policy.Steps {
+		field := fmt.Sprintf("steps[%d]", i)
+		step.Users = normalizeUsers(step.Users)
+		switch {
+		case step.AfterSeconds < 0:
+			violations.Add(field+".afterSeconds", "the step runs before the deadline")
+		case i > 0 && step.AfterSeconds < policy.Steps[i-1].AfterSeconds:
+			violations.Add(field+".afterSeconds", "the step runs before the one above it")
+		}
+		switch step.Target {
+		case pb.EscalationTarget_OWNER, pb.EscalationTarget_LIST_ADMINS:
+		case pb.EscalationTarget_USERS:
+			if len(step.Users) == 0 {
+				violations.Add(field+".users", "name the users to notify")
+			}
+		case pb.EscalationTarget_ESCALATION_TARGET_UNSPECIFIED:
+			violations.Add(field+".target", "set who to notify")
+		default:
+			violations.Add(field+".target", fmt.Sprintf("unknown target %d", step.Target))
+		}
+	}
+	return violations.Err()
+}
+
+func (s *Server) getEscalationPolicy(id int64) (*pb.EscalationPolicy, error) {
+	policy, err := scanEscalationPolicy(s.Db.QueryRow("SELECT "+escalationPolicyColumns+" FROM escalation_policies WHERE policyId = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "escalation policy %d not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading escalation policy %d: %v", id, err)
+	}
+	return policy, nil
+}
+
+// CreateEscalationPolicy adds an active policy for a list without one.
+func (s *Server) CreateEscalationPolicy(ctx context.Context, in *pb.EscalationPolicyRequest) (*pb.EscalationPolicyResponse, error) {
+	if in == nil || in.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "Policy is nil")
+	}
+	policy := proto.Clone(in.Policy).(*pb.EscalationPolicy)
+	policy.PolicyId = 0
+	if err := normalizeEscalationPolicy(s.Db, policy); err != nil {
+		return nil, err
+	}
+	steps, err := marshalSteps(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "INSERT INTO escalation_policies (name, list, steps, active, createdAt) VALUES (?, ?, ?, 1, ?)"
+	res, err := s.Db.Exec(query, policy.Name, policy.List, steps, s.now().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating escalation policy: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating escalation policy: %v", err)
+	}
+	policy, err = s.getEscalationPolicy(id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EscalationPolicyResponse{Policy: policy}, nil
+}
+
+// UpdateEscalationPolicy replaces every field of a policy but its creation
+// time, and its active flag when the request sets it. Tasks keep the steps they
+// went through, new steps run when they are due.
+func (s *Server) UpdateEscalationPolicy(ctx context.Context, in *pb.EscalationPolicyRequest) (*pb.EscalationPolicyResponse, error) {
+	if in == nil || in.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "Policy is nil")
+	}
+	if in.Policy.PolicyId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "PolicyId is empty")
+	}
+	policy := proto.Clone(in.Policy).(*pb.EscalationPolicy)
+	if err := normalizeEscalationPolicy(s.Db, policy); err != nil {
+		return nil, err
+	}
+	steps, err := marshalSteps(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "UPDATE escalation_policies SET name = ?, list = ?, steps = ?, active = COALESCE(?, active) WHERE policyId = ?"
+	res, err := s.Db.Exec(query, policy.Name, policy.List, steps, policy.Active, policy.PolicyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "updating escalation policy %d: %v", policy.PolicyId, err)
+	}
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "escalation policy %d not found", policy.PolicyId)
+	}
+	policy, err = s.getEscalationPolicy(policy.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EscalationPolicyResponse{Policy: policy}, nil
+}
+
+// DeleteEscalationPolicy removes a policy and forgets how far tasks got in it.
+// The notifications it sent stay with their recipients.
+func (s *Server) DeleteEscalationPolicy(ctx context.Context, in *pb.EscalationPolicyRequest) (*pb.DeleteEscalationPolicyResponse, error) {
+	if in == nil || in.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "Policy is nil")
+	}
+
+	var deleted bool
+	err := s.inTx(func(tx *txn) error {
+		res, err := tx.Exec("DELETE FROM escalation_policies WHERE policyId = ?", in.Policy.PolicyId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "deleting escalation policy %d: %v", in.Policy.PolicyId, err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return status.Errorf(codes.Internal, "deleting escalation policy %d: %v", in.Policy.PolicyId, err)
+		}
+		if rowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		if _, err := tx.Exec("DELETE FROM task_escalations WHERE policyId = ?", in.Policy.PolicyId); err != nil {
+			return status.Errorf(codes.Internal, "deleting the escalations of policy %d: %v", in.Policy.PolicyId, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteEscalationPolicyResponse{Success: deleted}, nil
+}
+
+// ListEscalationPolicies returns every policy, the default one first
+func (s *Server) ListEscalationPolicies(ctx context.Context, in *pb.EscalationPolicyRequest) (*pb.ListEscalationPoliciesResponse, error) {
+	policies, err := escalationPolicies(s.Db, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListEscalationPoliciesResponse{Policies: policies}, nil
+}
+
+// escalationPolicies loads the policies sorted by list, only the active ones if activeOnly.
+func escalationPolicies(q queryer, activeOnly bool) ([]*pb.EscalationPolicy, error) {
+	query := "SELECT " + escalationPolicyColumns + " FROM escalation_policies"
+	if activeOnly {
+		query += " WHERE active = 1"
+	}
+	rows, err := q.Query(query + " ORDER BY list ASC")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the escalation policies: %v", err)
+	}
+	defer rows.Close()
+
+	var policies []*pb.EscalationPolicy
+	for rows.Next() {
+		policy, err := scanEscalationPolicy(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reading an escalation policy: %v", err)
+		}
+		policies = append(policies, policy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the escalation policies: %v", err)
+	}
+	return policies, nil
+}
+
+// SetListAdmins replaces the admins of a list, none to remove them all.
+func (s *Server) SetListAdmins(ctx context.Context, in *pb.ListAdmins) (*pb.ListAdmins, error) {
+	if in == nil || strings.TrimSpace(in.List) == "" {
+		return nil, status.Error(codes.InvalidArgument, "List is empty")
+	}
+	admins := &pb.ListAdmins{List: strings.TrimSpace(in.List), Admins: normalizeUsers(in.Admins)}
+	err := s.inTx(func(tx *txn) error {
+		if _, err := tx.Exec("DELETE FROM list_admins WHERE list = ?", admins.List); err != nil {
+			return status.Errorf(codes.Internal, "removing the admins of list %q: %v", admins.List, err)
+		}
+		for _, admin := range admins.Admins {
+			if _, err := tx.Exec("INSERT INTO list_admins (list, admin) VALUES (?, ?)", admins.List, admin); err != nil {
+				return status.Errorf(codes.Internal, "adding admin %s to list %q: %v", admin, admins.List, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return admins, nil
+}
+
+// GetListAdmins returns the admins of a list
+func (s *Server) GetListAdmins(ctx context.Context, in *pb.ListAdmins) (*pb.ListAdmins, error) {
+	if in == nil || strings.TrimSpace(in.List) == "" {
+		return nil, status.Error(codes.InvalidArgument, "List is empty")
+	}
+	list := strings.TrimSpace(in.List)
+	admins, err := listAdmins(s.Db, list)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAdmins{List: list, Admins: admins}, nil
+}
+
+// listAdmins loads the sorted admins of a list.
+func listAdmins(q queryer, list string) ([]string, error) {
+	rows, err := q.Query("SELECT admin FROM list_admins WHERE list = ? ORDER BY admin ASC", list)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the admins of list %q: %v", list, err)
+	}
+	defer rows.Close()
+
+	var admins []string
+	for rows.Next() {
+		var admin string
+		if err := rows.Scan(&admin); err != nil {
+			return nil, status.Errorf(codes.Internal, "reading an admin of list %q: %v", list, err)
+		}
+		admins = append(admins, admin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the admins of list %q: %v", list, err)
+	}
+	return admins, nil
+}
+
+// taskOwner returns the assignee of a task, or the user who created it if it
+// has none. Tasks created anonymously or by the server have no owner.
+func taskOwner(q queryer, task *pb.Task) (string, error) {
+	if task.Assignee != "" {
+		return task.Assignee, nil
+	}
+	var creator string
+	query := "SELECT actor FROM task_history WHERE taskId = ? AND action = ? ORDER BY historyId ASC LIMIT 1"
+	err := q.QueryRow(query, task.TaskId, ActionCreate).Scan(&creator)
+	if err != nil && err != sql.ErrNoRows {
+		return "", status.Errorf(codes.Internal, "reading the creator of task %d: %v", task.TaskId, err)
+	}
+	switch creator {
+	case AnonymousActor, SystemActor, AutomationActor:
+		return "", nil
+	}
+	return creator, nil
+}
+
+// escalationRecipients returns who a step notifies about task.
+func escalationRecipients(q queryer, step *pb.EscalationStep, task *pb.Task) ([]string, error) {
+	switch step.Target {
+	case pb.EscalationTarget_OWNER:
+		owner, err := taskOwner(q, task)
+		if err != nil || owner == "" {
+			return nil, err
+		}
+		return []string{owner}, nil
+	case pb.EscalationTarget_LIST_ADMINS:
+		return listAdmins(q, task.List)
+	}
+	return step.Users, nil
+}
+
+// EscalateOverdueTasks runs the steps that are due for the tasks flagged
+// overdue, in order, following the policy of their list or the default one.
+// How far each task got is kept per deadline, so no step notifies twice. It
+// returns how many notifications it sent.
+func (s *Server) EscalateOverdueTasks(ctx context.Context) (int, error) {
+	var notified int
+	err := s.inTx(func(tx *txn) error {
+		policies, err := escalationPolicies(tx, true)
+		if err != nil || len(policies) == 0 {
+			return err
+		}
+		byList := map[string]*pb.EscalationPolicy{}
+		for _, policy := range policies {
+			byList[policy.List] = policy
+		}
+		tasks, err := queryTasks(tx, "SELECT "+taskColumns+" FROM tasks WHERE overdueAt != 0 AND deletedAt = 0 AND complete = 0 ORDER BY taskId ASC")
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			policy, ok := byList[task.List]
+			if !ok {
+				if policy, ok = byList[""]; !ok {
+					continue
+				}
+			}
+			// A task that moved to a list with another policy starts over
+			var policyId, stepsDone int64
+			query := "SELECT policyId, stepsDone FROM task_escalations WHERE taskId = ? AND deadline = ?"
+			err := tx.QueryRow(query, task.TaskId, task.Deadline).Scan(&policyId, &stepsDone)
+			if err != nil && err != sql.ErrNoRows {
+				return status.Errorf(codes.Internal, "reading the escalation of task %d: %v", task.TaskId, err)
+			}
+			if policyId != policy.PolicyId {
+				stepsDone = 0
+			}
+
+			done := stepsDone
+			for ; done < int64(len(policy.Steps)); done++ {
+				step := policy.Steps[done]
+				if tx.now.Unix() < task.Deadline+step.AfterSeconds {
+					break
+				}
+				recipients, err := escalationRecipients(tx, step, task)
+				if err != nil {
+					return err
+				}
+				message := expandPlaceholders(step.Message, task)
+				if message == "" {
+					overdue := tx.now.Sub(time.Unix(task.Deadline, 0))
+					message = fmt.Sprintf("Task %d %q is %s overdue", task.TaskId, task.Title, formatSpan(overdue))
+				}
+				for _, recipient := range recipients {
+					notification := &pb.Notification{Recipient: recipient, TaskId: task.TaskId, PolicyId: policy.PolicyId, Message: message}
+					if err := notify(tx, notification); err != nil {
+						return err
+					}
+					notified++
+				}
+			}
+			if done == stepsDone && policyId == policy.PolicyId {
+				continue
+			}
+			query = `INSERT INTO task_escalations (taskId, deadline, policyId, stepsDone, updatedAt) VALUES (?, ?, ?, ?, ?)
+				ON CONFLICT (taskId, deadline) DO UPDATE SET policyId = excluded.policyId, stepsDone = excluded.stepsDone, updatedAt = excluded.updatedAt`
+			if _, err := tx.Exec(query, task.TaskId, task.Deadline, policy.PolicyId, done, tx.now.Unix()); err != nil {
+				return status.Errorf(codes.Internal, "recording the escalation of task %d: %v", task.TaskId, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return notified, nil
+}
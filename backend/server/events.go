@@ -0,0 +1,141 @@
+package server
+
+import (
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it is dropped.
+const subscriberBuffer = 256
+
+// EventBus fans the change events of committed transactions out to in-process
+// subscribers. Sequence numbers are task_history ids, so the history table can
+// replay anything a subscriber missed.
+type EventBus struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+// Subscription receives the events published after it was created.
+type Subscription struct {
+	bus     *EventBus
+	events  chan *pb.TaskEvent
+	dropped bool
+}
+
+// NewEventBus returns an empty event bus.
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: map[*Subscription]struct{}{}}
+}
+
+// bus returns the server event bus, creating it on first use.
+func (s *Server) bus() *EventBus {
+	s.busOnce.Do(func() {
+		if s.Events == nil {
+			s.Events = NewEventBus()
+		}
+	})
+	return s.Events
+}
+
+// Subscribe starts receiving events. Close the subscription when done.
+func (b *EventBus) Subscribe() *Subscription {
+	sub := &Subscription{bus: b, events: make(chan *pb.TaskEvent, subscriberBuffer)}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Publish delivers events to every subscriber. A subscriber whose buffer is
+// full is dropped and its channel closed rather than blocking the publisher.
+func (b *EventBus) Publish(events ...*pb.TaskEvent) {
+	if len(events) == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subscribers {
+		for _, event := range events {
+			select {
+			case sub.events <- event:
+			default:
+				sub.dropped = true
+				delete(b.subscribers, sub)
+				close(sub.events)
+			}
+			if sub.dropped {
+				break
+			}
+		}
+	}
+}
+
+// Events is closed when the subscription is closed or dropped.
+func (sub *Subscription) Events() <-chan *pb.TaskEvent {
+	return sub.events
+}
+
+// Dropped reports whether the subscription was dropped for falling behind.
+func (sub *Subscription) Dropped() bool {
+	sub.bus.mu.Lock()
+	defer sub.bus.mu.Unlock()
+	return sub.dropped
+}
+
+// Close stops the subscription.
+func (sub *Subscription) Close() {
+	sub.bus.mu.Lock()
+	defer sub.bus.mu.Unlock()
+	if _, ok := sub.bus.subscribers[sub]; ok {
+		delete(sub.bus.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// toEvent converts a history entry to the change event it stands for.
+func (e *historyEntry) toEvent() *pb.TaskEvent {
+	event := &pb.TaskEvent{
+		Sequence:    e.historyId,
+		Type:        eventType(e.before, e.after),
+		Task:        e.after,
+		Actor:       e.actor,
+		Time:        e.changedAt,
+		ResumeToken: strconv.FormatInt(e.historyId, 10),
+	}
+	if e.after == nil {
+		event.Task = e.before
+	}
+	return event
+}
+
+// eventType derives the kind of change from the snapshots around it.
+func eventType(before, after *pb.Task) pb.EventType {
+	switch {
+	case before == nil:
+		return pb.EventType_TASK_CREATED
+	case after == nil:
+		return pb.EventType_TASK_PURGED
+	case before.DeletedAt == 0 && after.DeletedAt != 0:
+		return pb.EventType_TASK_DELETED
+	case before.DeletedAt != 0 && after.DeletedAt == 0:
+		return pb.EventType_TASK_RESTORED
+	case !before.Complete && after.Complete:
+		return pb.EventType_TASK_COMPLETED
+	}
+	return pb.EventType_TASK_UPDATED
+}
+
+// parseResumeToken returns the sequence number a resume token points at.
+func parseResumeToken(token string) (int64, error) {
+	sequence, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || sequence < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid resume token %q", token)
+	}
+	return sequence, nil
+}
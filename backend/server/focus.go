@@ -0,0 +1,357 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// DefaultFocusMinutes is the length of a focus session when none is given.
+const DefaultFocusMinutes = 25
+
+// maxFocusMinutes is the longest focus session.
+const maxFocusMinutes = 120
+
+// focusSessionColumns lists the focus_sessions columns in the order scanFocusSession reads them.
+const focusSessionColumns = "sessionId, taskId, user, entryId, startedAt, plannedSeconds, endedAt, state"
+
+// scanFocusSession reads a row selected with focusSessionColumns, without its
+// interruptions. Running sessions count their seconds up to now.
+func scanFocusSession(row rowScanner, now time.Time) (*pb.FocusSession, error) {
+	session := &pb.FocusSession{}
+	err := row.Scan(&session.SessionId, &session.TaskId, &session.User, &session.EntryId, &session.StartedAt,
+		&session.PlannedSeconds, &session.EndedAt, &session.State)
+	if err != nil {
+		return nil, err
+	}
+	end := session.EndedAt
+	if session.State == pb.FocusState_FOCUSING {
+		end = now.Unix()
+	}
+	session.Seconds = max(end-session.StartedAt, 0)
+	return session, nil
+}
+
+// loadInterruptions fills in the interruptions of sessions.
+func loadInterruptions(q queryer, sessions ...*pb.FocusSession) error {
+	for _, session := range sessions {
+		rows, err := q.Query("SELECT at, note FROM focus_interruptions WHERE sessionId = ? ORDER BY interruptionId", session.SessionId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "reading the interruptions of focus session %d: %v", session.SessionId, err)
+		}
+		for rows.Next() {
+			interruption := &pb.FocusInterruption{}
+			if err := rows.Scan(&interruption.At, &interruption.Note); err != nil {
+				rows.Close()
+				return status.Errorf(codes.Internal, "reading the interruptions of focus session %d: %v", session.SessionId, err)
+			}
+			session.Interruptions = append(session.Interruptions, interruption)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return status.Errorf(codes.Internal, "reading the interruptions of focus session %d: %v", session.SessionId, err)
+		}
+	}
+	return nil
+}
+
+// queryFocusSessions runs a query selecting focusSessionColumns and collects
+// the sessions with their interruptions.
+func queryFocusSessions(q queryer, now time.Time, query string, args ...any) ([]*pb.FocusSession, error) {
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing focus sessions: %v", err)
+	}
+	var sessions []*pb.FocusSession
+	for rows.Next() {
+		session, err := scanFocusSession(rows, now)
+		if err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "reading a focus session: %v", err)
+		}
+		sessions = append(sessions, session)
+	}
+	err = rows.Err()
+	rows.Close() // Closed before loading the interruptions, the database has a single connection
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading focus sessions: %v", err)
+	}
+	if err := loadInterruptions(q, sessions...); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// focusingSession returns the session user is running, nil if there is none.
+func focusingSession(q queryer, user string, now time.Time) (*pb.FocusSession, error) {
+	sessions, err := queryFocusSessions(q, now, "SELECT "+focusSessionColumns+" FROM focus_sessions WHERE user = ? AND state = ?",
+		user, pb.FocusState_FOCUSING)
+	if err != nil || len(sessions) == 0 {
+		return nil, err
+	}
+	return sessions[0], nil
+}
+
+func getFocusSession(q queryer, id int64, now time.Time) (*pb.FocusSession, error) {
+	session, err := scanFocusSession(q.QueryRow("SELECT "+focusSessionColumns+" FROM focus_sessions WHERE sessionId = ?", id), now)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "focus session %d not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading focus session %d: %v", id, err)
+	}
+	if err := loadInterruptions(q, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// endFocusSession ends the session timed by a time entry that stops, if any.
+// It finishes once it ran its planned length, unless cutShort, and then
+// counts on its task.
+func endFocusSession(tx *txn, entryId int64, cutShort bool) error {
+	query := "SELECT " + focusSessionColumns + " FROM focus_sessions WHERE entryId = ? AND state = ?"
+	session, err := scanFocusSession(tx.QueryRow(query, entryId, pb.FocusState_FOCUSING), tx.now)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "reading the focus session of time entry %d: %v", entryId, err)
+	}
+	state := pb.FocusState_CUT_SHORT
+	if !cutShort && session.Seconds >= session.PlannedSeconds {
+		state = pb.FocusState_FINISHED
+	}
+	if _, err := tx.Exec("UPDATE focus_sessions SET endedAt = ?, state = ? WHERE sessionId = ?", tx.now.Unix(), state, session.SessionId); err != nil {
+		return status.Errorf(codes.Internal, "ending focus session %d: %v", session.SessionId, err)
+	}
+	if state == pb.FocusState_FINISHED {
+		if _, err := tx.Exec("UPDATE tasks SET focusSessions = focusSessions + 1 WHERE taskId = ?", session.TaskId); err != nil {
+			return status.Errorf(codes.Internal, "counting focus session %d: %v", session.SessionId, err)
+		}
+	}
+	return nil
+}
+
+// StartFocusSession starts a Pomodoro on a task for the caller, timing it
+// with a new timer. The timer the caller runs, and its session, are stopped first.
+func (s *Server) StartFocusSession(ctx context.Context, in *pb.FocusRequest) (*pb.FocusSessionResponse, error) {
+	if in == nil || in.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TaskId is empty")
+	}
+	minutes := in.Minutes
+	if minutes == 0 {
+		minutes = DefaultFocusMinutes
+	}
+	if minutes < 0 || minutes > maxFocusMinutes {
+		return nil, validators.FieldError("minutes", fmt.Sprintf("a session lasts 1 to %d minutes", maxFocusMinutes))
+	}
+
+	user := ActorFromContext(ctx)
+	res := &pb.FocusSessionResponse{}
+	err := s.inTx(func(tx *txn) error {
+		if _, err := trackableTask(tx, in.TaskId); err != nil {
+			return err
+		}
+		running, err := runningTimer(tx, user, tx.now)
+		if err != nil {
+			return err
+		}
+		if running != nil {
+			if res.Stopped, err = stopTimer(tx, running, ""); err != nil {
+				return err
+			}
+		}
+		entry, err := insertTimer(tx, user, in.TaskId, in.Note)
+		if err != nil {
+			return err
+		}
+		query := "INSERT INTO focus_sessions (entryId, taskId, user, startedAt, plannedSeconds, state) VALUES (?, ?, ?, ?, ?, ?)"
+		inserted, err := tx.Exec(query, entry.EntryId, in.TaskId, user, entry.StartedAt, int64(minutes)*60, pb.FocusState_FOCUSING)
+		if err != nil {
+			return status.Errorf(codes.Internal, "starting the focus session: %v", err)
+		}
+		id, err := inserted.LastInsertId()
+		if err != nil {
+			return status.Errorf(codes.Internal, "starting the focus session: %v", err)
+		}
+		if res.Session, err = getFocusSession(tx, id, tx.now); err != nil {
+			return err
+		}
+		res.Task, err = getTask(tx, in.TaskId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// InterruptFocusSession records what interrupted the running session of the caller.
+func (s *Server) InterruptFocusSession(ctx context.Context, in *pb.FocusRequest) (*pb.FocusSessionResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Request is nil")
+	}
+	user := ActorFromContext(ctx)
+	res := &pb.FocusSessionResponse{}
+	err := s.inTx(func(tx *txn) error {
+		session, err := focusingSession(tx, user, tx.now)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			return status.Errorf(codes.FailedPrecondition, "%s is not focusing", user)
+		}
+		query := "INSERT INTO focus_interruptions (sessionId, at, note) VALUES (?, ?, ?)"
+		if _, err := tx.Exec(query, session.SessionId, tx.now.Unix(), strings.TrimSpace(in.Note)); err != nil {
+			return status.Errorf(codes.Internal, "recording the interruption: %v", err)
+		}
+		if res.Session, err = getFocusSession(tx, session.SessionId, tx.now); err != nil {
+			return err
+		}
+		res.Task, err = loggedTask(tx, session.TaskId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StopFocusSession stops the running session of the caller with its timer.
+// It finishes if it ran its planned length and is cut short otherwise.
+func (s *Server) StopFocusSession(ctx context.Context, in *pb.FocusRequest) (*pb.FocusSessionResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Request is nil")
+	}
+	user := ActorFromContext(ctx)
+	res := &pb.FocusSessionResponse{}
+	err := s.inTx(func(tx *txn) error {
+		session, err := focusingSession(tx, user, tx.now)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			return status.Errorf(codes.FailedPrecondition, "%s is not focusing", user)
+		}
+		entry, err := getTimeEntry(tx, session.EntryId, tx.now)
+		if err != nil {
+			return err
+		}
+		if _, err := stopTimer(tx, entry, in.Note); err != nil {
+			return err
+		}
+		if res.Session, err = getFocusSession(tx, session.SessionId, tx.now); err != nil {
+			return err
+		}
+		res.Task, err = loggedTask(tx, session.TaskId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListFocusSessions returns the session history, most recent first,
+// optionally of one task or user.
+func (s *Server) ListFocusSessions(ctx context.Context, in *pb.FocusSessionsRequest) (*pb.FocusSessionsResponse, error) {
+	var where []string
+	var args []any
+	if in.GetTaskId() != 0 {
+		where = append(where, "taskId = ?")
+		args = append(args, in.TaskId)
+	}
+	if user := strings.TrimSpace(in.GetUser()); user != "" {
+		where = append(where, "user = ?")
+		args = append(args, user)
+	}
+	query := "SELECT " + focusSessionColumns + " FROM focus_sessions"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	sessions, err := queryFocusSessions(s.Db, s.now(), query+" ORDER BY startedAt DESC, sessionId DESC", args...)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FocusSessionsResponse{Sessions: sessions}, nil
+}
+
+// countSession adds a session to stats.
+func countSession(stats *pb.FocusStats, session *pb.FocusSession) {
+	stats.Sessions++
+	switch session.State {
+	case pb.FocusState_FINISHED:
+		stats.Finished++
+	case pb.FocusState_CUT_SHORT:
+		stats.CutShort++
+	}
+	stats.FocusSeconds += session.Seconds
+	stats.Interruptions += int64(len(session.Interruptions))
+}
+
+// GetFocusStats counts the focus sessions of a user per day and task. Days are
+// in the time zone of the caller and sessions count in the day they started.
+func (s *Server) GetFocusStats(ctx context.Context, in *pb.FocusStatsRequest) (*pb.FocusStatsResponse, error) {
+	loc, err := userLocation(ctx, s.Db)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().In(loc)
+	from, until, err := parseDayRange(in.GetFrom(), in.GetUntil(), now)
+	if err != nil {
+		return nil, err
+	}
+	user := strings.TrimSpace(in.GetUser())
+	if user == "" {
+		user = ActorFromContext(ctx)
+	}
+	end := until.AddDate(0, 0, 1)
+	sessions, err := queryFocusSessions(s.Db, now, "SELECT "+focusSessionColumns+" FROM focus_sessions WHERE user = ? AND startedAt >= ? AND startedAt < ?",
+		user, from.Unix(), end.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.FocusStatsResponse{User: user, TimeZone: loc.String(), Total: &pb.FocusStats{}}
+	days := map[string]*pb.FocusStats{}
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		stats := &pb.FocusStats{Date: day.Format(DeadlineDateLayout)}
+		days[stats.Date] = stats
+		res.Days = append(res.Days, stats)
+	}
+	tasks := map[int64]*pb.FocusStats{}
+	for _, session := range sessions {
+		countSession(days[time.Unix(session.StartedAt, 0).In(loc).Format(DeadlineDateLayout)], session)
+		countSession(res.Total, session)
+		stats, ok := tasks[session.TaskId]
+		if !ok {
+			stats = &pb.FocusStats{TaskId: session.TaskId}
+			task, err := loggedTask(s.Db, session.TaskId)
+			if err != nil {
+				return nil, err
+			}
+			if task != nil {
+				stats.Title = task.Title
+			}
+			tasks[session.TaskId] = stats
+			res.Tasks = append(res.Tasks, stats)
+		}
+		countSession(stats, session)
+	}
+	slices.SortFunc(res.Tasks, func(a, b *pb.FocusStats) int {
+		return cmp.Or(cmp.Compare(b.Finished, a.Finished), cmp.Compare(b.FocusSeconds, a.FocusSeconds), cmp.Compare(a.TaskId, b.TaskId))
+	})
+	return res, nil
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+func TestFocusSessions(t *testing.T) {
+	ctx := context.Background()
+	testServer, fake := newFakeClockServer(t)
+	alice := WithActor(ctx, "alice")
+	var ids []int64
+	for _, title := range []string{"Write chapter", "Fix build"} {
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "Work", ExitCriteria: "Done", Deadline: fake.Now().Add(48 * time.Hour).Unix(),
+		}})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", title, err)
+		}
+		ids = append(ids, res.Task.TaskId)
+	}
+
+	// A full session with an interruption
+	if _, err := testServer.StartFocusSession(alice, &pb.FocusRequest{TaskId: ids[0]}); err != nil {
+		t.Fatalf("StartFocusSession: %v", err)
+	}
+	fake.Advance(10 * time.Minute)
+	if _, err := testServer.InterruptFocusSession(alice, &pb.FocusRequest{Note: "Phone call"}); err != nil {
+		t.Fatalf("InterruptFocusSession: %v", err)
+	}
+	fake.Advance(15 * time.Minute)
+	res, err := testServer.StopFocusSession(alice, &pb.FocusRequest{Note: "Intro"})
+	if err != nil {
+		t.Fatalf("StopFocusSession: %v", err)
+	}
+	if session := res.Session; session.State != pb.FocusState_FINISHED || session.Seconds != 25*60 || len(session.Interruptions) != 1 {
+		t.Errorf("Session = %v, want 25 minutes finished with one interruption", session)
+	}
+	if res.Task.FocusSessions != 1 || res.Task.TrackedSeconds != 25*60 {
+		t.Errorf("Task = %v, want one session and its time logged", res.Task)
+	}
+
+	// Starting a timer on another task cuts the next session short
+	if _, err := testServer.StartFocusSession(alice, &pb.FocusRequest{TaskId: ids[0], Minutes: 50}); err != nil {
+		t.Fatalf("StartFocusSession: %v", err)
+	}
+	fake.Advance(5 * time.Minute)
+	if _, err := testServer.StartTimer(alice, &pb.TimerRequest{TaskId: ids[1]}); err != nil {
+		t.Fatalf("StartTimer: %v", err)
+	}
+	if _, err := testServer.StopFocusSession(alice, &pb.FocusRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("StopFocusSession without a session = %v, want FailedPrecondition", err)
+	}
+
+	// Starting a session stops the timer, deleting the timer of a session cuts it short
+	fake.Advance(5 * time.Minute)
+	started, err := testServer.StartFocusSession(alice, &pb.FocusRequest{TaskId: ids[1]})
+	if err != nil {
+		t.Fatalf("StartFocusSession: %v", err)
+	}
+	if started.Stopped.GetTaskId() != ids[1] || started.Stopped.Seconds != 5*60 {
+		t.Errorf("Stopped = %v, want the timer of the build", started.Stopped)
+	}
+	fake.Advance(30 * time.Minute)
+	if _, err := testServer.DeleteTimeEntry(alice, &pb.TimeEntryRequest{Entry: &pb.TimeEntry{EntryId: started.Session.EntryId}}); err != nil {
+		t.Fatalf("DeleteTimeEntry: %v", err)
+	}
+
+	sessions, err := testServer.ListFocusSessions(ctx, &pb.FocusSessionsRequest{User: "alice"})
+	if err != nil {
+		t.Fatalf("ListFocusSessions: %v", err)
+	}
+	var got [][]any
+	for _, session := range sessions.Sessions {
+		got = append(got, []any{session.TaskId, session.State, session.PlannedSeconds, session.Seconds})
+	}
+	want := [][]any{
+		{ids[1], pb.FocusState_CUT_SHORT, int64(25 * 60), int64(30 * 60)},
+		{ids[0], pb.FocusState_CUT_SHORT, int64(50 * 60), int64(5 * 60)},
+		{ids[0], pb.FocusState_FINISHED, int64(25 * 60), int64(25 * 60)},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Sessions (-want,+got):%v", diff)
+	}
+	build, _ := testServer.GetTask(ctx, ids[1])
+	if build.FocusSessions != 0 || build.TrackedSeconds != 5*60 {
+		t.Errorf("Build has %d sessions and %d seconds, want none and the 5 minute timer", build.FocusSessions, build.TrackedSeconds)
+	}
+
+	stats, err := testServer.GetFocusStats(alice, &pb.FocusStatsRequest{From: "2030-03-01"})
+	if err != nil {
+		t.Fatalf("GetFocusStats: %v", err)
+	}
+	wantTotal := &pb.FocusStats{Sessions: 3, Finished: 1, CutShort: 2, FocusSeconds: 60 * 60, Interruptions: 1}
+	if stats.Total.String() != wantTotal.String() || len(stats.Days) != 1 || stats.Days[0].Sessions != 3 {
+		t.Errorf("GetFocusStats = %v, want %v in one day", stats, wantTotal)
+	}
+	if len(stats.Tasks) != 2 || stats.Tasks[0].Title != "Write chapter" || stats.Tasks[0].Finished != 1 {
+		t.Errorf("Tasks = %v, want the chapter first", stats.Tasks)
+	}
+}
+
+func TestStartFocusSession_Invalid(t *testing.T) {
+	ctx := context.Background()
+	testServer, _ := newFakeClockServer(t)
+	_, err := testServer.StartFocusSession(ctx, &pb.FocusRequest{TaskId: 1, Minutes: 180})
+	if diff := cmp.Diff(map[string]string{"minutes": "a session lasts 1 to 120 minutes"}, validators.FieldViolations(err)); diff != "" {
+		t.Errorf("StartFocusSession (-want,+got):%v", diff)
+	}
+	if _, err := testServer.StartFocusSession(ctx, &pb.FocusRequest{TaskId: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("StartFocusSession of a missing task = %v, want NotFound", err)
+	}
+	if _, err := testServer.InterruptFocusSession(ctx, &pb.FocusRequest{Note: "Meeting"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("InterruptFocusSession without a session = %v, want FailedPrecondition", err)
+	}
+}
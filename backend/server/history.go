@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "taskify/backend/proto"
+)
+
+// History actions
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionRestore = "restore"
+	ActionPurge   = "purge"
+	ActionUndo    = "undo"
+	ActionRedo    = "redo"
+)
+
+// historyColumns lists the task_history columns in the order scanHistoryEntry reads them.
+const historyColumns = "historyId, taskId, action, actor, changedAt, before, after"
+
+// historyEntry is a decoded task_history row.
+type historyEntry struct {
+	historyId int64
+	taskId    int64
+	action    string
+	actor     string
+	changedAt int64
+	before    *pb.Task
+	after     *pb.Task
+}
+
+// scanHistoryEntry reads a row selected with historyColumns.
+func scanHistoryEntry(row rowScanner) (*historyEntry, error) {
+	entry := &historyEntry{}
+	var before, after sql.NullString
+	if err := row.Scan(&entry.historyId, &entry.taskId, &entry.action, &entry.actor, &entry.changedAt, &before, &after); err != nil {
+		return nil, err
+	}
+	var err error
+	if entry.before, err = unmarshalSnapshot(before); err != nil {
+		return nil, err
+	}
+	if entry.after, err = unmarshalSnapshot(after); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// toProto converts the entry to a TaskChange with its field diff.
+func (e *historyEntry) toProto() *pb.TaskChange {
+	return &pb.TaskChange{
+		HistoryId: e.historyId,
+		TaskId:    e.taskId,
+		Action:    e.action,
+		Actor:     e.actor,
+		ChangedAt: e.changedAt,
+		Changes:   diffTasks(e.before, e.after),
+	}
+}
+
+// recordHistory appends a change to the task history. before is nil for
+// created tasks and after is nil for purged ones.
+func recordHistory(ctx context.Context, tx *txn, taskId int64, action string, before, after *pb.Task) error {
+	return recordRevert(ctx, tx, taskId, action, 0, before, after)
+}
+
+// recordRevert appends a change that reverts the history entry revertsId, or
+// a plain change if revertsId is 0, and queues the matching change event.
+func recordRevert(ctx context.Context, tx *txn, taskId int64, action string, revertsId int64, before, after *pb.Task) error {
+	beforeJSON, err := marshalSnapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := marshalSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	entry := &historyEntry{
+		taskId:    taskId,
+		action:    action,
+		actor:     ActorFromContext(ctx),
+		changedAt: tx.now.Unix(),
+		before:    before,
+		after:     after,
+	}
+	reverts := sql.NullInt64{Int64: revertsId, Valid: revertsId != 0}
+	query := `INSERT INTO task_history (taskId, action, actor, changedAt, before, after, revertsId) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	res, err := tx.Exec(query, taskId, action, entry.actor, entry.changedAt, beforeJSON, afterJSON, reverts)
+	if err != nil {
+		return status.Errorf(codes.Internal, "recording history of task %d: %v", taskId, err)
+	}
+	if entry.historyId, err = res.LastInsertId(); err != nil {
+		return status.Errorf(codes.Internal, "recording history of task %d: %v", taskId, err)
+	}
+	tx.events = append(tx.events, entry.toEvent())
+	return recordTransition(tx, before, after)
+}
+
+// marshalSnapshot encodes a task for the history, nil is stored as NULL.
+func marshalSnapshot(task *pb.Task) (sql.NullString, error) {
+	if task == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := protojson.Marshal(task)
+	if err != nil {
+		return sql.NullString{}, status.Errorf(codes.Internal, "encoding task snapshot: %v", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// unmarshalSnapshot decodes a task stored by marshalSnapshot.
+func unmarshalSnapshot(data sql.NullString) (*pb.Task, error) {
+	if !data.Valid {
+		return nil, nil
+	}
+	task := &pb.Task{}
+	if err := protojson.Unmarshal([]byte(data.String), task); err != nil {
+		return nil, status.Errorf(codes.Internal, "decoding task snapshot: %v", err)
+	}
+	return task, nil
+}
+
+// GetTaskHistory returns every recorded change of a task, oldest first
+func (s *Server) GetTaskHistory(ctx context.Context, in *pb.TaskRequest) (*pb.TaskHistoryResponse, error) {
+	if in == nil || in.Task == nil {
+		return nil, status.Error(codes.InvalidArgument, "Task is nil")
+	}
+	if in.Task.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TaskId is empty")
+	}
+
+	query := "SELECT " + historyColumns + " FROM task_history WHERE taskId = ? ORDER BY historyId ASC"
+	rows, err := s.Db.Query(query, in.Task.TaskId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to query history: %v", err))
+	}
+	defer rows.Close()
+
+	var changes []*pb.TaskChange
+	for rows.Next() {
+		entry, err := scanHistoryEntry(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to scan row: %v", err))
+		}
+		changes = append(changes, entry.toProto())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Error encountered during iteration: %v", err))
+	}
+	if len(changes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "task %d has no history", in.Task.TaskId)
+	}
+	return &pb.TaskHistoryResponse{Changes: changes}, nil
+}
+
+// diffTasks lists the fields whose value differs between two snapshots. A nil
+// snapshot has no field set, so creations and purges list every set field.
+func diffTasks(before, after *pb.Task) []*pb.FieldChange {
+	var changes []*pb.FieldChange
+	fields := (&pb.Task{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Name() == "deadlineTime" || field.Name() == "overdue" {
+			continue // Derived from deadline and overdueAt, which are diffed already
+		}
+		if !hasField(before, field) && !hasField(after, field) {
+			continue
+		}
+		beforeValue, afterValue := formatField(before, field), formatField(after, field)
+		if beforeValue != afterValue {
+			changes = append(changes, &pb.FieldChange{
+				Field:  string(field.Name()),
+				Before: beforeValue,
+				After:  afterValue,
+			})
+		}
+	}
+	return changes
+}
+
+func hasField(task *pb.Task, field protoreflect.FieldDescriptor) bool {
+	return task != nil && task.ProtoReflect().Has(field)
+}
+
+// formatField renders a task field for the history, empty for a nil task.
+func formatField(task *pb.Task, field protoreflect.FieldDescriptor) string {
+	if task == nil {
+		return ""
+	}
+	value := task.ProtoReflect().Get(field)
+	switch {
+	case field.IsList():
+		list := value.List()
+		items := make([]string, list.Len())
+		for i := range items {
+			items[i] = fmt.Sprint(list.Get(i).Interface())
+		}
+		return strings.Join(items, ", ")
+	case field.Kind() == protoreflect.MessageKind:
+		if !value.Message().IsValid() {
+			return ""
+		}
+		data, _ := protojson.Marshal(value.Message().Interface())
+		return string(data)
+	case field.Kind() == protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+	}
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "taskify/backend/proto"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetTaskHistory(t *testing.T) {
+	testServer := Server{Db: initializeTestingDatabase(t)}
+	alice := WithActor(context.Background(), "alice")
+	bob := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorMetadataKey, "bob"))
+
+	task := createTestTask(t, &testServer, "Test Task")
+
+	oldDeadline := task.Deadline
+	task.Deadline = time.Now().Add(48 * time.Hour).Unix()
+	res, err := testServer.UpdateTask(alice, &pb.TaskRequest{Task: task})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if _, err := testServer.DeleteTask(bob, &pb.TaskRequest{Task: res.Task}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	history, err := testServer.GetTaskHistory(context.Background(), &pb.TaskRequest{Task: &pb.Task{TaskId: task.TaskId}})
+	if err != nil {
+		t.Fatalf("GetTaskHistory: %v", err)
+	}
+
+	want := []*pb.TaskChange{
+		{TaskId: task.TaskId, Action: ActionCreate, Actor: AnonymousActor},
+		{TaskId: task.TaskId, Action: ActionUpdate, Actor: "alice", Changes: []*pb.FieldChange{
+			{Field: "deadline", Before: fmt.Sprint(oldDeadline), After: fmt.Sprint(task.Deadline)},
+		}},
+		{TaskId: task.TaskId, Action: ActionDelete, Actor: "bob"},
+	}
+	opts := []cmp.Option{
+		cmpopts.IgnoreUnexported(pb.TaskChange{}, pb.FieldChange{}),
+		cmpopts.IgnoreFields(pb.TaskChange{}, "HistoryId", "ChangedAt"),
+	}
+	// Creations and deletions list many fields, only check the update diff exactly
+	got := history.Changes
+	if len(got) != len(want) {
+		t.Fatalf("GetTaskHistory returned %d changes, want %d: %v", len(got), len(want), got)
+	}
+	if len(got[0].Changes) == 0 || got[0].Changes[0].Field != "taskId" {
+		t.Errorf("Create entry should list the initial fields, got %v", got[0].Changes)
+	}
+	if len(got[2].Changes) == 0 || got[2].Changes[0].Field != "deletedAt" {
+		t.Errorf("Delete entry should change deletedAt, got %v", got[2].Changes)
+	}
+	got[0].Changes, got[2].Changes = nil, nil
+	got[1].Changes = withoutVersionChanges(got[1].Changes)
+	if diff := cmp.Diff(want, got, opts...); diff != "" {
+		t.Errorf("GetTaskHistory (-want,+got):%v", diff)
+	}
+}
+
+func TestGetTaskHistory_Unknown_Task(t *testing.T) {
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	_, err := testServer.GetTaskHistory(context.Background(), &pb.TaskRequest{Task: &pb.Task{TaskId: 42}})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetTaskHistory of an unknown task: got %v, want NotFound", err)
+	}
+}
+
+func TestTaskHistory_Append_Only(t *testing.T) {
+	testServer := Server{Db: initializeTestingDatabase(t)}
+	createTestTask(t, &testServer, "Test Task")
+
+	if _, err := testServer.Db.Exec("UPDATE task_history SET actor = 'mallory'"); err == nil {
+		t.Error("Updating task_history should fail")
+	}
+	if _, err := testServer.Db.Exec("DELETE FROM task_history"); err == nil {
+		t.Error("Deleting from task_history should fail")
+	}
+}
+
+// withoutVersionChanges drops the bookkeeping fields every change touches.
+func withoutVersionChanges(changes []*pb.FieldChange) []*pb.FieldChange {
+	var kept []*pb.FieldChange
+	for _, change := range changes {
+		if change.Field != "version" && change.Field != "updateTime" {
+			kept = append(kept, change)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyMetadataKey is the gRPC metadata key, and HTTP header, carrying the
+// idempotency key of a request.
+const IdempotencyMetadataKey = "idempotency-key"
+
+// DefaultIdempotencyTTL is how long the response to an idempotent request is kept.
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// maxIdempotencyKeyLength bounds the keys clients may send.
+const maxIdempotencyKeyLength = 255
+
+type idempotencyKey struct{}
+
+// WithIdempotencyKey returns a context whose request is retried safely under key.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, strings.TrimSpace(key))
+}
+
+// IdempotencyKeyFromContext returns the idempotency key of the request, read
+// from WithIdempotencyKey or the incoming gRPC metadata, empty if there is none.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	if key, ok := ctx.Value(idempotencyKey{}).(string); ok && key != "" {
+		return key
+	}
+	if values := metadata.ValueFromIncomingContext(ctx, IdempotencyMetadataKey); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// idempotencyTTL returns the configured time to keep responses.
+func (s *Server) idempotencyTTL() time.Duration {
+	if s.IdempotencyTTL <= 0 {
+		return DefaultIdempotencyTTL
+	}
+	return s.IdempotencyTTL
+}
+
+// idempotent runs fn, which fills response, at most once per idempotency key
+// of the caller within the TTL. A retry with the same key and request gets the
+// stored response back instead, and reusing a key for a different request is
+// rejected. Without a key fn simply runs.
+func (s *Server) idempotent(ctx context.Context, tx *txn, method string, request, response proto.Message, fn func() error) error {
+	key := IdempotencyKeyFromContext(ctx)
+	if key == "" {
+		return fn()
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d characters", maxIdempotencyKeyLength)
+	}
+
+	hash, err := requestHash(request)
+	if err != nil {
+		return err
+	}
+	actor := ActorFromContext(ctx)
+	cutoff := tx.now.Add(-s.idempotencyTTL()).Unix()
+	if _, err := tx.Exec("DELETE FROM idempotency_keys WHERE createdAt <= ?", cutoff); err != nil {
+		return status.Errorf(codes.Internal, "expiring idempotency keys: %v", err)
+	}
+
+	var storedHash, stored string
+	query := "SELECT requestHash, response FROM idempotency_keys WHERE actor = ? AND method = ? AND idempotencyKey = ?"
+	err = tx.QueryRow(query, actor, method, key).Scan(&storedHash, &stored)
+	switch {
+	case err == nil:
+		if storedHash != hash {
+			return status.Errorf(codes.InvalidArgument, "idempotency key %q was already used for a different request", key)
+		}
+		if err := protojson.Unmarshal([]byte(stored), response); err != nil {
+			return status.Errorf(codes.Internal, "decoding stored response: %v", err)
+		}
+		return nil
+	case err != sql.ErrNoRows:
+		return status.Errorf(codes.Internal, "reading idempotency key: %v", err)
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+	data, err := protojson.Marshal(response)
+	if err != nil {
+		return status.Errorf(codes.Internal, "encoding response: %v", err)
+	}
+	query = "INSERT INTO idempotency_keys (actor, method, idempotencyKey, requestHash, response, createdAt) VALUES (?, ?, ?, ?, ?, ?)"
+	if _, err := tx.Exec(query, actor, method, key, hash, string(data), tx.now.Unix()); err != nil {
+		return status.Errorf(codes.Internal, "storing idempotency key: %v", err)
+	}
+	return nil
+}
+
+// requestHash fingerprints a request so a reused key can be told apart from a retry.
+func requestHash(request proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "encoding request: %v", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
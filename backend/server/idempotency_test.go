@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func idempotentTaskRequest(title string) *pb.TaskRequest {
+	return &pb.TaskRequest{Task: &pb.Task{
+		Title:        title,
+		Description:  "This is the task",
+		Deadline:     time.Now().Add(time.Hour).Unix(),
+		ExitCriteria: "Finish it",
+	}}
+}
+
+func TestCreateTask_IdempotencyKey(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyMetadataKey, "create-1"))
+	req := idempotentTaskRequest("Retried Task")
+
+	first, err := testServer.CreateTask(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	retry, err := testServer.CreateTask(ctx, req)
+	if err != nil {
+		t.Fatalf("Retried CreateTask: %v", err)
+	}
+	if retry.Task.TaskId != first.Task.TaskId || retry.Task.Version != first.Task.Version {
+		t.Errorf("Retry returned task %v, want %v", retry.Task, first.Task)
+	}
+	if count := countTasks(t, testServer); count != 1 {
+		t.Errorf("%d tasks stored, want 1", count)
+	}
+
+	// Without the key the retry hits the unique constraint
+	if _, err := testServer.CreateTask(context.Background(), req); err == nil {
+		t.Errorf("CreateTask without an idempotency key created a duplicate")
+	}
+}
+
+func TestCreateTask_IdempotencyKeyReused(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	ctx := WithIdempotencyKey(context.Background(), "create-1")
+
+	if _, err := testServer.CreateTask(ctx, idempotentTaskRequest("First Task")); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	_, err := testServer.CreateTask(ctx, idempotentTaskRequest("Second Task"))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Reusing the key for another task returned %v, want InvalidArgument", err)
+	}
+
+	// Keys are scoped to the user sending them
+	if _, err := testServer.CreateTask(WithActor(ctx, "bob"), idempotentTaskRequest("Second Task")); err != nil {
+		t.Errorf("Another user could not use the same key: %v", err)
+	}
+}
+
+func TestCreateTask_IdempotencyKeyExpires(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	testServer := &Server{Db: initializeTestingDatabase(t), IdempotencyTTL: time.Minute, Clock: fake}
+	ctx := WithIdempotencyKey(context.Background(), "create-1")
+	req := idempotentTaskRequest("Expiring Task")
+
+	first, err := testServer.CreateTask(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	fake.Advance(time.Minute)
+	if _, err := testServer.DeleteTask(context.Background(), &pb.TaskRequest{Task: first.Task}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if _, err := testServer.PurgeTask(context.Background(), &pb.TaskRequest{Task: first.Task}); err != nil {
+		t.Fatalf("PurgeTask: %v", err)
+	}
+
+	second, err := testServer.CreateTask(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateTask after the key expired: %v", err)
+	}
+	if second.Task.TaskId == first.Task.TaskId {
+		t.Errorf("An expired key replayed task %d", first.Task.TaskId)
+	}
+}
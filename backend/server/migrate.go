@@ -0,0 +1,138 @@
+package server
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// columnMigrations adds the columns introduced after a database file was first
+// created. init.sql only creates what is missing, so existing tables would
+// otherwise never see them.
+var columnMigrations = []struct {
+	table      string
+	column     string
+	definition string
+}{
+	{"tasks", "deletedAt", "INTEGER DEFAULT 0"},
+	{"tasks", "version", "INTEGER DEFAULT 1"},
+	{"tasks", "updateTime", "INTEGER DEFAULT 0"},
+	{"tasks", "list", "TEXT DEFAULT ''"},
+	{"tasks", "deadlineDate", "TEXT DEFAULT ''"},
+	{"tasks", "tags", "TEXT DEFAULT ''"},
+	{"tasks", "priority", "INTEGER DEFAULT 0"},
+	{"tasks", "startAt", "INTEGER DEFAULT 0"},
+	{"tasks", "createdAt", "INTEGER DEFAULT 0"},
+	{"tasks", "completedAt", "INTEGER DEFAULT 0"},
+	{"tasks", "assignee", "TEXT DEFAULT ''"},
+	{"tasks", "dependsOn", "TEXT DEFAULT ''"},
+	{"tasks", "estimateMinutes", "INTEGER DEFAULT 0"},
+	{"tasks", "overdueAt", "INTEGER DEFAULT 0"},
+	{"tasks", "trackedSeconds", "INTEGER DEFAULT 0"},
+	{"tasks", "focusSessions", "INTEGER DEFAULT 0"},
+	{"task_history", "revertsId", "INTEGER"},
+	{"user_settings", "suggestionWeights", "TEXT NOT NULL DEFAULT ''"},
+	{"notifications", "policyId", "INTEGER NOT NULL DEFAULT 0"},
+}
+
+// migrateDatabase adds the missing columns to the tables that already exist,
+// and drops the UNIQUE constraint init.sql replaced with an index.
+func migrateDatabase(db *sql.DB) error {
+	for _, m := range columnMigrations {
+		columns, err := tableColumns(db, m.table)
+		if err != nil {
+			return err
+		}
+		if len(columns) == 0 || columns[m.column] {
+			continue // The table will be created by init.sql, or is up to date
+		}
+		query := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", m.table, m.column, m.definition)
+		if _, err := db.Exec(query); err != nil {
+			return fmt.Errorf("adding %s.%s: %w", m.table, m.column, err)
+		}
+	}
+	return dropTaskUniqueConstraint(db)
+}
+
+// dropTaskUniqueConstraint rebuilds a tasks table created with the UNIQUE
+// constraint on its texts and deadline, which also covered the tasks in the
+// trash. init.sql then creates the unique index on the other tasks. SQLite
+// can't drop a constraint, so the rows are copied to a table without it.
+func dropTaskUniqueConstraint(db *sql.DB) error {
+	var constraints int
+	if err := db.QueryRow("SELECT COUNT(*) FROM pragma_index_list('tasks') WHERE origin = 'u'").Scan(&constraints); err != nil {
+		return fmt.Errorf("reading indexes of tasks: %w", err)
+	}
+	if constraints == 0 {
+		return nil
+	}
+
+	rows, err := db.Query("SELECT name, type, \"notnull\", dflt_value, pk FROM pragma_table_info('tasks')")
+	if err != nil {
+		return fmt.Errorf("reading columns of tasks: %w", err)
+	}
+	var definitions []string
+	for rows.Next() {
+		var name, typ string
+		var notNull, pk bool
+		var defaultValue sql.NullString
+		if err := rows.Scan(&name, &typ, &notNull, &defaultValue, &pk); err != nil {
+			rows.Close()
+			return err
+		}
+		definition := name + " " + typ
+		if pk {
+			definition += " PRIMARY KEY AUTOINCREMENT"
+		}
+		if notNull {
+			definition += " NOT NULL"
+		}
+		if defaultValue.Valid {
+			definition += " DEFAULT " + defaultValue.String
+		}
+		definitions = append(definitions, definition)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, query := range []string{
+		"CREATE TABLE tasks_rebuilt (" + strings.Join(definitions, ", ") + ")",
+		"INSERT INTO tasks_rebuilt SELECT * FROM tasks",
+		// Ids of purged tasks are not handed out again
+		"DELETE FROM sqlite_sequence WHERE name = 'tasks_rebuilt'",
+		"INSERT INTO sqlite_sequence (name, seq) SELECT 'tasks_rebuilt', seq FROM sqlite_sequence WHERE name = 'tasks'",
+		"DROP TABLE tasks",
+		"ALTER TABLE tasks_rebuilt RENAME TO tasks",
+	} {
+		if _, err := tx.Exec(query); err != nil {
+			return fmt.Errorf("dropping the UNIQUE constraint of tasks: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// tableColumns returns the set of column names of table, empty if it does not exist.
+func tableColumns(db *sql.DB, table string) (map[string]bool, error) {
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, fmt.Errorf("reading columns of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	columns := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		columns[name] = true
+	}
+	return columns, rows.Err()
+}
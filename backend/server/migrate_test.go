@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	pb "taskify/backend/proto"
+)
+
+func TestMigrateDatabase_TrashedDuplicates(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open test database: %v", err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	// A database created before the trash, with the UNIQUE constraint
+	deadline := time.Now().Add(time.Hour).Unix()
+	for _, query := range []string{
+		`CREATE TABLE tasks (
+			taskId INTEGER PRIMARY KEY AUTOINCREMENT,
+			title VARCHAR(255), description TEXT, deadline INTEGER, exitCriteria TEXT, complete INTEGER,
+			UNIQUE (title, deadline, description, exitCriteria)
+		)`,
+		"INSERT INTO tasks (title, description, deadline, exitCriteria, complete) VALUES ('Pay rent', 'Monthly', ?, 'Paid', 0), ('Purged', 'Gone', ?, 'Gone', 0)",
+		"DELETE FROM tasks WHERE taskId = 2",
+	} {
+		if _, err := db.Exec(query, deadline, deadline); err != nil {
+			t.Fatalf("Exec(%s): %v", query, err)
+		}
+	}
+	if err := migrateDatabase(db); err != nil {
+		t.Fatalf("migrateDatabase: %v", err)
+	}
+	schema, err := os.ReadFile("../../database/init.sql")
+	if err != nil {
+		t.Fatalf("Failed to read init.sql: %v", err)
+	}
+	if _, err := db.Exec(string(schema)); err != nil {
+		t.Fatalf("Failed to initialize the migrated schema: %v", err)
+	}
+
+	testServer := &Server{Db: db}
+	rent := &pb.Task{Title: "Pay rent", Description: "Monthly", Deadline: deadline, ExitCriteria: "Paid"}
+	if _, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: rent}); err == nil {
+		t.Error("CreateTask of an open duplicate succeeded, want AlreadyExists")
+	}
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: &pb.Task{TaskId: 1}}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: rent})
+	if err != nil {
+		t.Fatalf("CreateTask of a trashed duplicate: %v", err)
+	}
+	if res.Task.TaskId != 3 {
+		t.Errorf("CreateTask = task %d, want 3 as purged ids are not reused", res.Task.TaskId)
+	}
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+)
+
+// pastDeadline reports whether task is open and its deadline has passed at now.
+func pastDeadline(task *pb.Task, now time.Time) bool {
+	return !task.Complete && task.Deadline != 0 && task.Deadline < now.Unix()
+}
+
+// overdueAt keeps the time a task was flagged overdue while it stays past its
+// deadline once changed from before to after, and clears it otherwise. Only
+// the overdue job flags tasks.
+func overdueAt(before, after *pb.Task, now time.Time) int64 {
+	if before == nil || !pastDeadline(after, now) {
+		return 0
+	}
+	return before.OverdueAt
+}
+
+// MarkOverdueTasks flags the open tasks past their deadline overdue and clears
+// the flag of the tasks that are no longer overdue, e.g. completed or trashed.
+// The flag is server state like updateTime: setting it keeps the version of
+// the task and is not recorded in its history. As change events are the
+// history, no event is published either: watchers and webhooks see the flag
+// with the next change of the task, and react to overdue tasks with
+// WHEN_OVERDUE automations and escalation policies. It returns how many tasks it flagged.
+func (s *Server) MarkOverdueTasks(ctx context.Context) (int, error) {
+	var marked int64
+	err := s.inTx(func(tx *txn) error {
+		now := tx.now.Unix()
+		query := "UPDATE tasks SET overdueAt = ? WHERE overdueAt = 0 AND deletedAt = 0 AND complete = 0 AND deadline != 0 AND deadline < ?"
+		res, err := tx.Exec(query, now, now)
+		if err != nil {
+			return status.Errorf(codes.Internal, "flagging overdue tasks: %v", err)
+		}
+		if marked, err = res.RowsAffected(); err != nil {
+			return status.Errorf(codes.Internal, "flagging overdue tasks: %v", err)
+		}
+		query = "UPDATE tasks SET overdueAt = 0 WHERE overdueAt != 0 AND (deletedAt != 0 OR complete != 0 OR deadline = 0 OR deadline >= ?)"
+		if _, err := tx.Exec(query, now); err != nil {
+			return status.Errorf(codes.Internal, "clearing the overdue flag: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(marked), nil
+}
+
+// StartOverdueChecker flags overdue tasks, escalates them and runs the
+// automations of overdue tasks every interval until ctx is cancelled.
+func (s *Server) StartOverdueChecker(ctx context.Context, interval time.Duration) {
+	ctx = WithActor(ctx, SystemActor)
+	ticker := s.timeSource().NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C():
+				s.checkOverdueTasks(ctx)
+			}
+		}
+	}()
+}
+
+// checkOverdueTasks runs every step of the overdue job, logging what each did.
+// Tasks are flagged first, so they are escalated in the same run.
+func (s *Server) checkOverdueTasks(ctx context.Context) {
+	if marked, err := s.MarkOverdueTasks(ctx); err != nil {
+		log.Printf("Flagging overdue tasks failed: %v", err)
+	} else if marked > 0 {
+		log.Printf("Flagged %d tasks overdue", marked)
+	}
+	if notified, err := s.EscalateOverdueTasks(ctx); err != nil {
+		log.Printf("Escalating overdue tasks failed: %v", err)
+	} else if notified > 0 {
+		log.Printf("Sent %d escalation notifications", notified)
+	}
+	if runs, err := s.RunOverdueAutomations(ctx); err != nil {
+		log.Printf("Overdue automations failed: %v", err)
+	} else if runs > 0 {
+		log.Printf("Ran %d automations for overdue tasks", runs)
+	}
+}
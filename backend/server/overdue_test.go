@@ -0,0 +1,236 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+func TestMarkOverdueTasks(t *testing.T) {
+	ctx := context.Background()
+	testServer, fake := newFakeClockServer(t)
+	create := func(title string, deadline time.Duration) *pb.Task {
+		t.Helper()
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "Due", ExitCriteria: "Done", Deadline: fake.Now().Add(deadline).Unix(),
+		}})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", title, err)
+		}
+		return res.Task
+	}
+	late := create("Late", time.Hour)
+	done := create("Done late", time.Hour)
+	create("On time", 48*time.Hour)
+
+	fake.Advance(2 * time.Hour)
+	for i, want := range []int{2, 0} {
+		if marked, err := testServer.MarkOverdueTasks(ctx); err != nil || marked != want {
+			t.Errorf("MarkOverdueTasks #%d = %d, %v, want %d", i+1, marked, err, want)
+		}
+	}
+	overdueTitles := func() []string {
+		t.Helper()
+		res, err := testServer.ListTasks(ctx, &pb.TaskRequest{OverdueOnly: true})
+		if err != nil {
+			t.Fatalf("ListTasks: %v", err)
+		}
+		var titles []string
+		for _, task := range res.Tasks {
+			if !task.Overdue || task.OverdueAt != fake.Now().Add(-time.Hour).Unix() {
+				t.Errorf("Task %q overdue = %v at %d, want flagged an hour ago", task.Title, task.Overdue, task.OverdueAt)
+			}
+			titles = append(titles, task.Title)
+		}
+		return titles
+	}
+	fake.Advance(time.Hour)
+	if diff := cmp.Diff([]string{"Late", "Done late"}, overdueTitles()); diff != "" {
+		t.Errorf("Overdue tasks (-want,+got):%v", diff)
+	}
+
+	// Flagging keeps the version, completing the task clears the flag right away
+	done, _ = testServer.GetTask(ctx, done.TaskId)
+	if done.Version != 1 {
+		t.Errorf("Version after flagging = %d, want 1", done.Version)
+	}
+	done.Complete = true
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: done}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if diff := cmp.Diff([]string{"Late"}, overdueTitles()); diff != "" {
+		t.Errorf("Overdue tasks after completing one (-want,+got):%v", diff)
+	}
+
+	// A change that keeps the task overdue keeps the flag
+	late, _ = testServer.GetTask(ctx, late.TaskId)
+	late.Description = "Still due"
+	if res, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: late}); err != nil || !res.Task.Overdue {
+		t.Errorf("UpdateTask = %v, %v, want the task still overdue", res, err)
+	}
+}
+
+func TestMarkOverdueTasks_NoEvent(t *testing.T) {
+	ctx := context.Background()
+	testServer, fake := newFakeClockServer(t)
+	if _, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+		Title: "Late", Description: "Due", ExitCriteria: "Done", Deadline: fake.Now().Add(time.Hour).Unix(),
+	}}); err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	sub := testServer.bus().Subscribe()
+
+	// Events are history entries, and the flag is kept out of the history
+	fake.Advance(2 * time.Hour)
+	if marked, err := testServer.MarkOverdueTasks(ctx); err != nil || marked != 1 {
+		t.Fatalf("MarkOverdueTasks = %d, %v, want 1", marked, err)
+	}
+	select {
+	case event := <-sub.events:
+		t.Errorf("MarkOverdueTasks published %v, want no event", event)
+	default:
+	}
+}
+
+func TestEscalateOverdueTasks(t *testing.T) {
+	ctx := context.Background()
+	testServer, fake := newFakeClockServer(t)
+	_, err := testServer.CreateEscalationPolicy(ctx, &pb.EscalationPolicyRequest{Policy: &pb.EscalationPolicy{
+		Name: "Ops", List: "ops",
+		Steps: []*pb.EscalationStep{
+			{Target: pb.EscalationTarget_OWNER},
+			{AfterSeconds: 24 * 60 * 60, Target: pb.EscalationTarget_LIST_ADMINS, Message: "{{title}} is still open"},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("CreateEscalationPolicy: %v", err)
+	}
+	_, err = testServer.CreateEscalationPolicy(ctx, &pb.EscalationPolicyRequest{Policy: &pb.EscalationPolicy{
+		Name: "Default", Steps: []*pb.EscalationStep{{Target: pb.EscalationTarget_USERS, Users: []string{"lead"}}},
+	}})
+	if err != nil {
+		t.Fatalf("CreateEscalationPolicy: %v", err)
+	}
+	if _, err := testServer.SetListAdmins(ctx, &pb.ListAdmins{List: "ops", Admins: []string{"dave", " carol", "dave"}}); err != nil {
+		t.Fatalf("SetListAdmins: %v", err)
+	}
+
+	// Unassigned, the owner is the user who created it
+	alice := WithActor(ctx, "alice")
+	for _, task := range []*pb.Task{
+		{Title: "Rotate keys", Description: "Ops", ExitCriteria: "Rotated", List: "ops", Deadline: fake.Now().Add(time.Hour).Unix()},
+		{Title: "Water plants", Description: "Office", ExitCriteria: "Watered", Deadline: fake.Now().Add(time.Hour).Unix(), Assignee: "bob"},
+	} {
+		if _, err := testServer.CreateTask(alice, &pb.TaskRequest{Task: task}); err != nil {
+			t.Fatalf("CreateTask(%s): %v", task.Title, err)
+		}
+	}
+
+	check := func(want int) {
+		t.Helper()
+		if _, err := testServer.MarkOverdueTasks(ctx); err != nil {
+			t.Fatalf("MarkOverdueTasks: %v", err)
+		}
+		if notified, err := testServer.EscalateOverdueTasks(ctx); err != nil || notified != want {
+			t.Errorf("EscalateOverdueTasks = %d, %v, want %d notifications", notified, err, want)
+		}
+	}
+	fake.Advance(3 * time.Hour)
+	check(2) // alice owns the ops task, the other one follows the default policy
+	check(0)
+	fake.Advance(24 * time.Hour)
+	check(2) // Both ops admins
+
+	var got [][]string
+	for _, user := range []string{"alice", "carol", "dave", "lead", "bob"} {
+		res, err := testServer.ListNotifications(WithActor(ctx, user), &pb.NotificationsRequest{})
+		if err != nil {
+			t.Fatalf("ListNotifications(%s): %v", user, err)
+		}
+		for _, notification := range res.Notifications {
+			got = append(got, []string{user, notification.Message})
+		}
+	}
+	want := [][]string{
+		{"alice", `Task 1 "Rotate keys" is 2 hours overdue`},
+		{"carol", "Rotate keys is still open"},
+		{"dave", "Rotate keys is still open"},
+		{"lead", `Task 2 "Water plants" is 2 hours overdue`},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Notifications (-want,+got):%v", diff)
+	}
+}
+
+func TestUpdateEscalationPolicy_Active(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	res, err := testServer.CreateEscalationPolicy(ctx, &pb.EscalationPolicyRequest{Policy: &pb.EscalationPolicy{
+		Name: "Ops", List: "ops", Steps: []*pb.EscalationStep{{Target: pb.EscalationTarget_OWNER}},
+	}})
+	if err != nil {
+		t.Fatalf("CreateEscalationPolicy: %v", err)
+	}
+	policy := res.Policy
+	activePolicies := func() int {
+		t.Helper()
+		policies, err := escalationPolicies(testServer.Db, true)
+		if err != nil {
+			t.Fatalf("escalationPolicies: %v", err)
+		}
+		return len(policies)
+	}
+
+	// An update that leaves out the active flag keeps it
+	policy.Name, policy.Active = "Operations", nil
+	if _, err := testServer.UpdateEscalationPolicy(ctx, &pb.EscalationPolicyRequest{Policy: policy}); err != nil {
+		t.Fatalf("UpdateEscalationPolicy: %v", err)
+	}
+	if active := activePolicies(); active != 1 {
+		t.Errorf("%d active policies after an update without active, want 1", active)
+	}
+
+	policy.Active = proto.Bool(false)
+	if _, err := testServer.UpdateEscalationPolicy(ctx, &pb.EscalationPolicyRequest{Policy: policy}); err != nil {
+		t.Fatalf("UpdateEscalationPolicy: %v", err)
+	}
+	if active := activePolicies(); active != 0 {
+		t.Errorf("%d active policies after deactivating, want none", active)
+	}
+}
+
+func TestCreateEscalationPolicy_Invalid(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	steps := []*pb.EscalationStep{{Target: pb.EscalationTarget_OWNER}}
+	if _, err := testServer.CreateEscalationPolicy(ctx, &pb.EscalationPolicyRequest{Policy: &pb.EscalationPolicy{List: "ops", Steps: steps}}); err != nil {
+		t.Fatalf("CreateEscalationPolicy: %v", err)
+	}
+	for _, tc := range []struct {
+		policy *pb.EscalationPolicy
+		want   map[string]string
+	}{
+		{&pb.EscalationPolicy{List: " ops", Steps: steps}, map[string]string{"list": `list "ops" already has policy 1`}},
+		{&pb.EscalationPolicy{}, map[string]string{"steps": "add at least one step"}},
+		{&pb.EscalationPolicy{Steps: []*pb.EscalationStep{
+			{AfterSeconds: 60, Target: pb.EscalationTarget_USERS},
+			{Target: pb.EscalationTarget_OWNER},
+			{AfterSeconds: 120},
+		}}, map[string]string{
+			"steps[0].users":        "name the users to notify",
+			"steps[1].afterSeconds": "the step runs before the one above it",
+			"steps[2].target":       "set who to notify",
+		}},
+	} {
+		_, err := testServer.CreateEscalationPolicy(ctx, &pb.EscalationPolicyRequest{Policy: tc.policy})
+		if diff := cmp.Diff(tc.want, validators.FieldViolations(err)); diff != "" {
+			t.Errorf("CreateEscalationPolicy(%v) (-want,+got):%v", tc.policy, diff)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	"taskify/backend/quickadd"
+)
+
+// quickAddExitCriteria are the exit criteria of quick added tasks, as a line
+// of text cannot give them.
+const quickAddExitCriteria = "Marked as done"
+
+// QuickAddTask creates a task from one line of text like
+// "Pay invoice tomorrow 5pm #finance !high". Dates are read in the time zone of
+// the caller, a line without one is due at the end of the caller's day. The
+// line is kept as the description and the exit criteria default to
+// quickAddExitCriteria, other fields the validator requires make the task fail
+// validation with their violations, so forms can ask for them.
+func (s *Server) QuickAddTask(ctx context.Context, in *pb.QuickAddRequest) (*pb.TaskResponse, error) {
+	text := strings.TrimSpace(in.GetText())
+	if text == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is empty")
+	}
+
+	var task *pb.Task
+	err := s.inTx(func(tx *txn) error {
+		loc, err := userLocation(ctx, tx)
+		if err != nil {
+			return err
+		}
+		task = quickadd.Parse(text, tx.now.In(loc))
+		task.Description = text
+		task.ExitCriteria = quickAddExitCriteria
+		if task.Deadline == 0 && task.DeadlineDate == "" {
+			task.DeadlineDate = tx.now.In(loc).Format(DeadlineDateLayout)
+		}
+		if in.Preview {
+			return applyCategoryRules(tx, task) // Shown the way createTask would categorize it
+		}
+		task, err = s.createTask(ctx, tx, task)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TaskResponse{Task: task}, nil
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+func TestQuickAddTask(t *testing.T) {
+	fake := clock.NewFake(time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC))
+	config := validators.DefaultConfig()
+	config.Required = []string{"title", "deadline"}
+	validator, err := validators.New(config, validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}
+	alice := WithActor(context.Background(), "alice")
+	if _, err := testServer.UpdateUserSettings(alice, &pb.UserSettings{TimeZone: "Asia/Tokyo"}); err != nil {
+		t.Fatalf("UpdateUserSettings: %v", err)
+	}
+
+	res, err := testServer.QuickAddTask(alice, &pb.QuickAddRequest{Text: "Pay invoice tomorrow 5pm #Finance !high"})
+	if err != nil {
+		t.Fatalf("QuickAddTask: %v", err)
+	}
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	task := res.Task
+	if task.Title != "Pay invoice" || task.Priority != pb.Priority_HIGH || len(task.Tags) != 1 || task.Tags[0] != "finance" {
+		t.Errorf("QuickAddTask() = %v, want the title, tag and priority read from the line", task)
+	}
+	if want := time.Date(2030, 3, 2, 17, 0, 0, 0, tokyo); task.Deadline != want.Unix() {
+		t.Errorf("Deadline = %v, want %v, tomorrow in the caller's time zone", time.Unix(task.Deadline, 0).In(tokyo), want)
+	}
+
+	filtered, err := testServer.ListTasks(alice, &pb.TaskRequest{Task: &pb.Task{Tags: []string{"#finance"}}})
+	if err != nil || len(filtered.Tasks) != 1 {
+		t.Errorf("ListTasks(#finance) = %v, %v, want the quick added task", filtered, err)
+	}
+}
+
+func TestQuickAddTask_Preview(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+
+	res, err := testServer.QuickAddTask(context.Background(), &pb.QuickAddRequest{Text: "Plan trip in 2 weeks", Preview: true})
+	if err != nil {
+		t.Fatalf("QuickAddTask(preview): %v", err)
+	}
+	if res.Task.Title != "Plan trip" || res.Task.DeadlineDate == "" {
+		t.Errorf("QuickAddTask(preview) = %v, want an all-day task titled Plan trip", res.Task)
+	}
+	if count := countTasks(t, testServer); count != 0 {
+		t.Errorf("A preview stored %d tasks", count)
+	}
+
+	// The default rules need exit criteria, which a line cannot give
+	res, err = testServer.QuickAddTask(context.Background(), &pb.QuickAddRequest{Text: "Plan trip in 2 weeks"})
+	if err != nil {
+		t.Fatalf("QuickAddTask: %v", err)
+	}
+	if res.Task.TaskId == 0 || res.Task.ExitCriteria != quickAddExitCriteria {
+		t.Errorf("QuickAddTask = %v, want the task stored with the default exit criteria", res.Task)
+	}
+
+	// A line without a date is due today
+	res, err = testServer.QuickAddTask(context.Background(), &pb.QuickAddRequest{Text: "Buy milk #errands"})
+	if err != nil {
+		t.Fatalf("QuickAddTask without a date: %v", err)
+	}
+	if today := time.Now().UTC().Format(DeadlineDateLayout); res.Task.DeadlineDate != today {
+		t.Errorf("QuickAddTask without a date is due %q, want today %q", res.Task.DeadlineDate, today)
+	}
+	if count := countTasks(t, testServer); count != 2 {
+		t.Errorf("QuickAddTask stored %d tasks, want 2", count)
+	}
+}
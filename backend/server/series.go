@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// Task states recorded in task_transitions.
+const (
+	StateOpen      = "open"
+	StateCompleted = "completed"
+	StateDeleted   = "deleted"
+)
+
+// DefaultSeriesDays is how many days GetTaskSeries reports when no start is given.
+const DefaultSeriesDays = 14
+
+// maxSeriesDays is the most days GetTaskSeries reports at once.
+const maxSeriesDays = 366
+
+// taskState is the state of a task for the series.
+func taskState(task *pb.Task) string {
+	switch {
+	case task.DeletedAt != 0:
+		return StateDeleted
+	case task.Complete:
+		return StateCompleted
+	}
+	return StateOpen
+}
+
+// recordTransition records the state of a task after a change that moved it
+// to another state, list or tags. Purges need none, the task was deleted before.
+func recordTransition(tx *txn, before, after *pb.Task) error {
+	if after == nil {
+		return nil
+	}
+	state, tags := taskState(after), strings.Join(after.Tags, " ")
+	if before != nil && taskState(before) == state && before.List == after.List && strings.Join(before.Tags, " ") == tags {
+		return nil
+	}
+	query := "INSERT INTO task_transitions (taskId, state, list, tags, at) VALUES (?, ?, ?, ?, ?)"
+	if _, err := tx.Exec(query, after.TaskId, state, after.List, tags, tx.now.Unix()); err != nil {
+		return status.Errorf(codes.Internal, "recording the state of task %d: %v", after.TaskId, err)
+	}
+	return nil
+}
+
+// GetTaskSeries counts the open and completed tasks at the end of every day
+// of a range, from the recorded transitions. Days are in the time zone of the
+// caller and today is counted as of now. Changes made before transitions were
+// recorded are not in the series.
+func (s *Server) GetTaskSeries(ctx context.Context, in *pb.SeriesRequest) (*pb.SeriesResponse, error) {
+	loc, err := userLocation(ctx, s.Db)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	until := today
+	if in.GetUntil() != "" {
+		if until, err = time.ParseInLocation(DeadlineDateLayout, in.Until, loc); err != nil {
+			return nil, validators.FieldError("until", "until must look like 2006-01-02")
+		}
+		if until.After(today) {
+			until = today // The future has no counts yet
+		}
+	}
+	from := until.AddDate(0, 0, 1-DefaultSeriesDays)
+	if in.GetFrom() != "" {
+		if from, err = time.ParseInLocation(DeadlineDateLayout, in.From, loc); err != nil {
+			return nil, validators.FieldError("from", "from must look like 2006-01-02")
+		}
+	}
+	if from.After(until) {
+		return nil, validators.FieldError("from", "from is after until")
+	}
+	if from.AddDate(0, 0, maxSeriesDays).Before(until) {
+		return nil, validators.FieldError("from", "a series covers at most 366 days")
+	}
+
+	// Each task counts in the state of its last transition at the end of the day
+	query := `SELECT COALESCE(SUM(state = ?), 0), COALESCE(SUM(state = ?), 0) FROM task_transitions
+		WHERE transitionId IN (SELECT MAX(transitionId) FROM task_transitions WHERE at <= ? GROUP BY taskId)`
+	var filters []any
+	if list := strings.TrimSpace(in.GetList()); list != "" {
+		query += " AND list = ?"
+		filters = append(filters, list)
+	}
+	if tags := normalizeTags([]string{in.GetTag()}); len(tags) > 0 {
+		query += " AND ' ' || tags || ' ' LIKE ?"
+		filters = append(filters, "% "+tags[0]+" %")
+	}
+
+	series := &pb.SeriesResponse{TimeZone: loc.String()}
+	for day := from; !day.After(until); day = day.AddDate(0, 0, 1) {
+		at := day.AddDate(0, 0, 1).Unix() - 1
+		if at > now.Unix() {
+			at = now.Unix()
+		}
+		point := &pb.SeriesPoint{Date: day.Format(DeadlineDateLayout), At: at}
+		args := append([]any{StateOpen, StateCompleted, at}, filters...)
+		if err := s.Db.QueryRowContext(ctx, query, args...).Scan(&point.Open, &point.Completed); err != nil {
+			return nil, status.Errorf(codes.Internal, "counting the tasks of %s: %v", point.Date, err)
+		}
+		series.Points = append(series.Points, point)
+	}
+	return series, nil
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetTaskSeries(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC))
+	validator, err := validators.New(validators.DefaultConfig(), validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}
+	create := func(title, list string, tags ...string) *pb.Task {
+		t.Helper()
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "Charted", ExitCriteria: "Done",
+			Deadline: fake.Now().Add(30 * 24 * time.Hour).Unix(), List: list, Tags: tags,
+		}})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", title, err)
+		}
+		return res.Task
+	}
+
+	// Day 1: three tasks are opened
+	first := create("First", "sprint", "backend")
+	second := create("Second", "sprint")
+	third := create("Third", "backlog", "backend")
+
+	// Day 2: one is completed and one is deleted
+	fake.Advance(24 * time.Hour)
+	first.Complete = true
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: first}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: third}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	// Day 3: the last one moves to the backlog
+	fake.Advance(24 * time.Hour)
+	second.List = "backlog"
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: second}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	dayEnd := func(day int) int64 {
+		return time.Date(2030, 3, day+1, 0, 0, 0, 0, time.UTC).Unix() - 1
+	}
+	opts := cmpopts.IgnoreUnexported(pb.SeriesResponse{}, pb.SeriesPoint{})
+	tests := []struct {
+		name string
+		req  *pb.SeriesRequest
+		want []*pb.SeriesPoint
+	}{
+		{"all tasks", &pb.SeriesRequest{From: "2030-02-28"}, []*pb.SeriesPoint{
+			{Date: "2030-02-28", At: dayEnd(0)},
+			{Date: "2030-03-01", At: dayEnd(1), Open: 3},
+			{Date: "2030-03-02", At: dayEnd(2), Open: 1, Completed: 1},
+			{Date: "2030-03-03", At: fake.Now().Unix(), Open: 1, Completed: 1},
+		}},
+		{"one list", &pb.SeriesRequest{From: "2030-03-01", Until: "2030-03-03", List: "sprint"}, []*pb.SeriesPoint{
+			{Date: "2030-03-01", At: dayEnd(1), Open: 2},
+			{Date: "2030-03-02", At: dayEnd(2), Open: 1, Completed: 1},
+			{Date: "2030-03-03", At: fake.Now().Unix(), Completed: 1},
+		}},
+		{"one tag", &pb.SeriesRequest{From: "2030-03-01", Until: "2030-03-02", Tag: "#backend"}, []*pb.SeriesPoint{
+			{Date: "2030-03-01", At: dayEnd(1), Open: 2},
+			{Date: "2030-03-02", At: dayEnd(2), Completed: 1},
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := testServer.GetTaskSeries(ctx, tc.req)
+			if err != nil {
+				t.Fatalf("GetTaskSeries: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, res.Points, opts); diff != "" {
+				t.Errorf("Points (-want,+got):%v", diff)
+			}
+		})
+	}
+
+	_, err = testServer.GetTaskSeries(ctx, &pb.SeriesRequest{From: "2030-03-03", Until: "2030-03-01"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetTaskSeries(from after until) returned %v, want InvalidArgument", err)
+	}
+}
@@ -3,25 +3,127 @@ package server
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
+	"net/http"
 	"os"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts" // gRPC package
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	"taskify/backend/clock"
 	pb "taskify/backend/proto" // Import your proto package (path should match where task.pb.go is located)
+	validators "taskify/backend/validators"
 
-	_ "github.com/mattn/go-sqlite3" // SQLite driver
+	sqlite3 "github.com/mattn/go-sqlite3" // SQLite driver
 )
 
 type Server struct {
-	pb.UnimplementedTaskServiceServer         // Embedding the Unimplemented service for forward compatibility
-	Db                                *sql.DB // Database
+	pb.UnimplementedTaskServiceServer                       // Embedding the Unimplemented service for forward compatibility
+	Db                                *sql.DB               // Database
+	TrashRetention                    time.Duration         // How long deleted tasks stay in the trash, DefaultTrashRetention if zero
+	UndoWindow                        time.Duration         // How long a change can be undone, DefaultUndoWindow if zero
+	Events                            *EventBus             // Receives the changes of every committed transaction, created on first use if nil
+	WebhookClient                     *http.Client          // Sends the webhook deliveries, a client with DefaultWebhookTimeout if nil
+	IdempotencyTTL                    time.Duration         // How long the responses of idempotent requests are kept, DefaultIdempotencyTTL if zero
+	Validator                         *validators.Validator // Checks tasks before they are stored, the default rules on Clock if nil
+	Clock                             clock.Clock           // Tells the time to everything in the server, the wall clock if nil
+
+	busOnce          sync.Once
+	validatorOnce    sync.Once
+	defaultValidator *validators.Validator
+}
+
+// timeSource returns the server clock, the wall clock if none was set.
+func (s *Server) timeSource() clock.Clock {
+	if s.Clock == nil {
+		return clock.Real()
+	}
+	return s.Clock
+}
+
+// now returns the current time of the server clock.
+func (s *Server) now() time.Time {
+	return s.timeSource().Now()
+}
+
+// taskColumns lists the tasks columns in the order scanTask reads them.
+const taskColumns = "taskId, title, description, deadline, exitCriteria, complete, deletedAt, version, updateTime, list, deadlineDate, tags, priority, startAt, createdAt, completedAt, assignee, dependsOn, estimateMinutes, overdueAt, trackedSeconds, focusSessions"
+
+// queryer is implemented by both *sql.DB and *sql.Tx, so helpers can run inside or outside a transaction.
+type queryer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// txn is a database transaction that also collects the change events to
+// publish once it commits. Every change it makes is stamped with its start time.
+type txn struct {
+	*sql.Tx
+	now    time.Time
+	events []*pb.TaskEvent
+
+	automationChains map[int][]int64 // Automations whose changes queued the event at each index, for loop protection
+}
+
+// inTx runs fn in a transaction, committing it if fn succeeds and rolling it back otherwise.
+// The events recorded by fn run the automations they trigger and are queued for
+// the webhooks in the same transaction, and only published after a successful commit.
+func (s *Server) inTx(fn func(tx *txn) error) error {
+	sqlTx, err := s.Db.Begin()
+	if err != nil {
+		return status.Errorf(codes.Internal, "starting transaction: %v", err)
+	}
+	tx := &txn{Tx: sqlTx, now: s.now()}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := s.runAutomations(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := queueWebhookDeliveries(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "committing transaction: %v", err)
+	}
+	s.bus().Publish(tx.events...)
+	return nil
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTask reads a row selected with taskColumns into a Task.
+func scanTask(row rowScanner) (*pb.Task, error) {
+	task := &pb.Task{}
+	var tags, dependsOn string
+	err := row.Scan(&task.TaskId, &task.Title, &task.Description, &task.Deadline, &task.ExitCriteria, &task.Complete, &task.DeletedAt, &task.Version, &task.UpdateTime, &task.List, &task.DeadlineDate, &tags, &task.Priority, &task.StartAt, &task.CreatedAt, &task.CompletedAt, &task.Assignee, &dependsOn, &task.EstimateMinutes, &task.OverdueAt, &task.TrackedSeconds, &task.FocusSessions)
+	if err != nil {
+		return nil, err
+	}
+	if tags != "" {
+		task.Tags = strings.Fields(tags)
+	}
+	if task.DependsOn, err = parseTaskIds(dependsOn); err != nil {
+		return nil, err
+	}
+	setDeadlineTime(task)
+	task.Overdue = task.OverdueAt != 0
+	return task, nil
 }
 
 func InitializeDatabase() (*sql.DB, error) {
@@ -34,12 +136,18 @@ func InitializeDatabase() (*sql.DB, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	db.SetMaxOpenConns(1) // SQLite only allows one writer at a time
 
 	schema, err := os.ReadFile(schemaFilePath + "init.sql") // Read schema from init.sql
 	if err != nil {
 		return nil, fmt.Errorf("failed to read schema file: %w", err)
 	}
 
+	// Older database files need their new columns before the schema creates indexes on them
+	if err := migrateDatabase(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
 	_, err = db.Exec(string(schema)) // Execute schema
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize database schema: %w", err)
@@ -49,40 +157,111 @@ func InitializeDatabase() (*sql.DB, error) {
 
 }
 
-// Validate task is complete
+// validator returns the configured validator, or one with the default rules
+// running on the server clock.
+func (s *Server) validator() *validators.Validator {
+	if s.Validator != nil {
+		return s.Validator
+	}
+	s.validatorOnce.Do(func() {
+		var err error
+		s.defaultValidator, err = validators.New(validators.DefaultConfig(), validators.WithClock(s.timeSource()))
+		if err != nil {
+			panic(err) // The default config is always valid
+		}
+	})
+	return s.defaultValidator
+}
 
-func (s *Server) validateTask(ctx context.Context, task *pb.Task) error {
-	if len(strings.TrimSpace(task.Title)) == 0 {
-		return status.Error(codes.NotFound, "Title was missing")
+// validateTask normalizes a task before it is stored and reports every invalid
+// field as an InvalidArgument error with field violations. All-day deadlines
+// are resolved in the time zone of the user making the request.
+func (s *Server) validateTask(ctx context.Context, tx *txn, task *pb.Task, isUpdate bool) error {
+	task.List = strings.TrimSpace(task.List)
+	task.Assignee = strings.TrimSpace(task.Assignee)
+	task.Tags = normalizeTags(task.Tags)
+	task.DependsOn = normalizeTaskIds(task.DependsOn)
+	loc, err := userLocation(ctx, tx)
+	if err != nil {
+		return err
+	}
+	var before *pb.Task
+	if isUpdate {
+		// The stored task tells which fields the update changes, updateTask reports a missing one
+		before, err = getTask(tx, task.TaskId)
+		if status.Code(err) == codes.NotFound {
+			before, err = &pb.Task{}, nil
+		}
+		if err != nil {
+			return err
+		}
 	}
-
-	if len(strings.TrimSpace(task.Description)) == 0 {
-		return status.Error(codes.NotFound, "Description was missing")
+	// A client that edits only one of deadline and deadlineTime sends the other unchanged
+	if err := normalizeDeadline(task, before.GetDeadline(), loc); err != nil {
+		return err
 	}
+	if err := s.validator().ValidateUpdate(task, before); err != nil {
+		return err
+	}
+	return validateDependencies(tx, task, before)
+}
 
-	if len(strings.TrimSpace(task.Title)) == 0 {
-		return status.Error(codes.NotFound, "Title was missing")
+// storageError converts an error writing a task to a status error. SQLite
+// UNIQUE violations mean the task already exists.
+func storageError(err error) error {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+		return status.Error(codes.AlreadyExists, "a task with the same title, description, deadline and exit criteria already exists")
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
 	}
+	return status.Errorf(codes.Internal, "storing the task: %v", err)
+}
+
+func (s *Server) GetTask(ctx context.Context, id int64) (*pb.Task, error) {
+	return getTask(s.Db, id)
+}
 
-	if len(strings.TrimSpace(task.ExitCriteria)) == 0 {
-		return status.Error(codes.NotFound, "Exit Criteria was missing")
+// normalizeTags drops the # and case of tags, and sorts them without duplicates.
+func normalizeTags(tags []string) []string {
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
 	}
+	slices.Sort(normalized)
+	return normalized
+}
 
-	if task.Deadline == 0 {
-		return status.Error(codes.NotFound, "Deadline was missing")
+// queryTasks runs a query selecting taskColumns and collects the tasks.
+func queryTasks(q queryer, query string, args ...any) ([]*pb.Task, error) {
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to query tasks: %v", err))
 	}
+	defer rows.Close() // Ensure the rows are properly closed when done.
 
-	if task.Deadline <= time.Now().Unix() {
-		return status.Error(codes.InvalidArgument, "Deadline must be in the future")
+	var tasks []*pb.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to scan row: %v", err))
+		}
+		tasks = append(tasks, task)
 	}
-	return nil
 
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Error encountered during iteration: %v", err))
+	}
+	return tasks, nil
 }
 
-func (s *Server) GetTask(ctx context.Context, id int64) (*pb.Task, error) {
-	var taskId, deadline, complete int
-	var title, description, exitCriteria string
-	err := s.Db.QueryRow("SELECT * FROM tasks WHERE taskId = ?", id).Scan(&taskId, &title, &description, &deadline, &exitCriteria, &complete)
+// getTask loads a task, trashed or not, through q.
+func getTask(q queryer, id int64) (*pb.Task, error) {
+	task, err := scanTask(q.QueryRow("SELECT "+taskColumns+" FROM tasks WHERE taskId = ?", id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, status.Errorf(codes.NotFound, "task %d not found %v", id, err)
@@ -90,36 +269,74 @@ func (s *Server) GetTask(ctx context.Context, id int64) (*pb.Task, error) {
 			return nil, status.Errorf(codes.Aborted, "fatal error: %v", err)
 		}
 	}
-	return &pb.Task{
-		TaskId:       int64(taskId),
-		Title:        title,
-		Description:  description,
-		Deadline:     int64(deadline),
-		ExitCriteria: exitCriteria,
-		Complete:     complete == 1,
-	}, nil
+	return task, nil
+}
+
+// writeTask overwrites every stored column of an existing task, used to put back an earlier snapshot.
+// The version keeps moving forward so clients holding the snapshot's version can't overwrite it.
+// The overdue flag is left to the overdue job, unless the snapshot is no longer overdue.
+func writeTask(tx *txn, task *pb.Task) error {
+	query := `UPDATE tasks SET title = ?, description = ?, deadline = ?, deadlineDate = ?, exitCriteria = ?, complete = ?, deletedAt = ?, list = ?,
+		tags = ?, priority = ?, startAt = ?, completedAt = ?, assignee = ?, dependsOn = ?, estimateMinutes = ?,
+		overdueAt = CASE WHEN ? THEN overdueAt ELSE 0 END, version = version + 1, updateTime = ? WHERE taskId = ?`
+	_, err := tx.Exec(query, task.Title, task.Description, task.Deadline, task.DeadlineDate, task.ExitCriteria, task.Complete, task.DeletedAt, task.List,
+		strings.Join(task.Tags, " "), task.Priority, task.StartAt, task.CompletedAt, task.Assignee, formatTaskIds(task.DependsOn), task.EstimateMinutes,
+		pastDeadline(task, tx.now), tx.now.Unix(), task.TaskId)
+	if err != nil {
+		return storageError(err)
+	}
+	return nil
 }
 
-// CreateTask will store the TaskRequest in the Database
+// CreateTask will store the TaskRequest in the Database. The response lists
+// the open tasks the new task looks like, and a task with the same text as an
+// open one once normalized is only created if the request allows duplicates.
 func (s *Server) CreateTask(ctx context.Context, in *pb.TaskRequest) (*pb.TaskResponse, error) {
-	if in == nil {
-		return nil, status.Error(codes.NotFound, "task is nil")
+	if in == nil || in.Task == nil {
+		return nil, status.Error(codes.InvalidArgument, "Task is nil")
 	}
 
-	err := s.validateTask(ctx, in.Task)
+	// A retry with the idempotency key of a created task returns that task again
+	response := &pb.TaskResponse{}
+	err := s.inTx(func(tx *txn) error {
+		return s.idempotent(ctx, tx, "CreateTask", in, response, func() error {
+			var err error
+			// Validation normalizes the task, a retry must still hash like the original
+			response.Task, err = s.createTask(ctx, tx, proto.Clone(in.Task).(*pb.Task))
+			if err != nil {
+				return err
+			}
+			// Compared once stored, with the deadline resolved like the other tasks
+			response.Duplicates, err = checkDuplicates(tx, response.Task, in.AllowDuplicates)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// createTask categorizes, validates and inserts a task inside tx.
+func (s *Server) createTask(ctx context.Context, tx *txn, in *pb.Task) (*pb.Task, error) {
+	if err := applyCategoryRules(tx, in); err != nil {
+		return nil, err
+	}
+	err := s.validateTask(ctx, tx, in, false)
 	if err != nil {
 		return nil, err
 	}
 
 	// Prepare the INSERT statement
-	query := `INSERT INTO tasks (title, description, deadline, exitCriteria, complete) 
-		VALUES (?, ?, ?, ?, ?)`
+	query := `INSERT INTO tasks (title, description, deadline, deadlineDate, exitCriteria, complete, list, tags, priority, startAt, createdAt, completedAt, assignee, dependsOn, estimateMinutes, version, updateTime) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1, ?)`
 
 	// Execute the insert query
-	task := in.Task
-	res, err := s.Db.Exec(query, task.Title, task.Description, task.Deadline, task.ExitCriteria, task.Complete)
+	res, err := tx.Exec(query, in.Title, in.Description, in.Deadline, in.DeadlineDate, in.ExitCriteria, in.Complete, in.List,
+		strings.Join(in.Tags, " "), in.Priority, in.StartAt, tx.now.Unix(), completedAt(nil, in, tx.now), in.Assignee, formatTaskIds(in.DependsOn), in.EstimateMinutes, tx.now.Unix())
 	if err != nil {
-		return nil, err
+		return nil, storageError(err)
 	}
 
 	taskId, err := res.LastInsertId()
@@ -127,165 +344,246 @@ func (s *Server) CreateTask(ctx context.Context, in *pb.TaskRequest) (*pb.TaskRe
 		return nil, err
 	}
 
-	task, err = s.GetTask(ctx, taskId)
+	task, err := getTask(tx, taskId)
 	if err != nil {
 		return nil, err
 	}
-
-	return &pb.TaskResponse{
-		Task: task,
-	}, nil
+	return task, recordHistory(ctx, tx, taskId, ActionCreate, nil, task)
 }
 
-// Updatetask will store update the TaskRequest in the Database
+// Updatetask will store update the TaskRequest in the Database.
+// The task must carry the version it was read at, if the stored task has moved
+// on since the update fails with Aborted instead of overwriting that change.
 func (s *Server) UpdateTask(ctx context.Context, in *pb.TaskRequest) (*pb.TaskResponse, error) {
-	err := s.validateTask(ctx, in.Task)
+	if in == nil || in.Task == nil {
+		return nil, status.Error(codes.InvalidArgument, "Task is nil")
+	}
+
+	var task *pb.Task
+	err := s.inTx(func(tx *txn) error {
+		var err error
+		task, err = s.updateTask(ctx, tx, in.Task)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	task, err := s.GetTask(ctx, in.Task.TaskId)
+	return &pb.TaskResponse{Task: task}, nil
+}
+
+// updateTask validates and applies an update inside tx.
+func (s *Server) updateTask(ctx context.Context, tx *txn, in *pb.Task) (*pb.Task, error) {
+	err := s.validateTask(ctx, tx, in, true)
+	if err != nil {
+		return nil, err
+	}
+	if in.Version == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Version was missing")
+	}
+
+	before, err := getTask(tx, in.TaskId)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving the task: %v", err)
 	}
+	if before.DeletedAt != 0 {
+		return nil, status.Errorf(codes.NotFound, "task %d is in the trash", before.TaskId)
+	}
+	if before.Version != in.Version {
+		return nil, versionMismatch(before, in.Version)
+	}
+	in.DeletedAt = before.DeletedAt // Only DeleteTask and RestoreTask change deletedAt
+	in.UpdateTime = before.UpdateTime
+	in.CreatedAt = before.CreatedAt
+	in.TrackedSeconds = before.TrackedSeconds // Only logging time changes it
+	in.FocusSessions = before.FocusSessions
+	in.CompletedAt = completedAt(before, in, tx.now)
+	in.OverdueAt = overdueAt(before, in, tx.now)
+	in.Overdue = in.OverdueAt != 0
 
-	if diff := cmp.Diff(in.Task, task, cmpopts.IgnoreUnexported(pb.Task{})); diff == "" {
+	if diff := cmp.Diff(in, before, cmpopts.IgnoreFields(pb.Task{}, "DeadlineTime"), cmpopts.IgnoreUnexported(pb.Task{})); diff == "" {
 		return nil, status.Error(codes.AlreadyExists, "no changes made")
 	}
 
-	query := "UPDATE tasks SET title = ?, description = ?, deadline = ?, exitCriteria = ?, complete = ? WHERE taskId = ?;"
+	query := `UPDATE tasks SET title = ?, description = ?, deadline = ?, deadlineDate = ?, exitCriteria = ?, complete = ?, list = ?,
+		tags = ?, priority = ?, startAt = ?, completedAt = ?, assignee = ?, dependsOn = ?, estimateMinutes = ?, overdueAt = ?, version = version + 1, updateTime = ? WHERE taskId = ? AND version = ?;`
 
-	res, err := s.Db.Exec(query, in.Task.Title, in.Task.Description, in.Task.Deadline, in.Task.ExitCriteria, in.Task.Complete, in.Task.TaskId)
+	res, err := tx.Exec(query, in.Title, in.Description, in.Deadline, in.DeadlineDate, in.ExitCriteria, in.Complete, in.List,
+		strings.Join(in.Tags, " "), in.Priority, in.StartAt, in.CompletedAt, in.Assignee, formatTaskIds(in.DependsOn), in.EstimateMinutes, in.OverdueAt, tx.now.Unix(), in.TaskId, in.Version)
 	if err != nil {
-		return nil, err
+		return nil, storageError(err)
 	}
-	lastAffectedRow, err := res.LastInsertId()
-	if err != nil {
-		return nil, err
+	if updated, err := res.RowsAffected(); err != nil || updated == 0 {
+		return nil, versionMismatch(before, in.Version)
 	}
-	task, err = s.GetTask(ctx, lastAffectedRow)
+	task, err := getTask(tx, in.TaskId)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving the task: %v", err)
 	}
-
-	return &pb.TaskResponse{Task: task}, nil
+	return task, recordHistory(ctx, tx, task.TaskId, ActionUpdate, before, task)
 }
 
-// DeleteTask will delete the task from the database
+// DeleteTask moves the task to the trash, where it stays until it is restored or purged.
+// When the task carries a version the delete only happens if it is still current.
 func (s *Server) DeleteTask(ctx context.Context, in *pb.TaskRequest) (*pb.DeleteTaskResponse, error) {
-	if in == nil {
+	if in == nil || in.Task == nil {
 		return nil, status.Error(codes.InvalidArgument, "Task is nil")
 	}
-	if in.Task.TaskId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "TaskId is empty")
+
+	var deleted bool
+	err := s.inTx(func(tx *txn) error {
+		var err error
+		deleted, err = s.deleteTask(ctx, tx, in.Task)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteTaskResponse{Success: deleted}, nil
+}
+
+// deleteTask moves a task to the trash inside tx. It reports false if the task
+// does not exist or already is in the trash.
+func (s *Server) deleteTask(ctx context.Context, tx *txn, in *pb.Task) (bool, error) {
+	if in.TaskId == 0 {
+		return false, status.Error(codes.InvalidArgument, "TaskId is empty")
 	}
 
-	// Delete Query
+	before, err := getTask(tx, in.TaskId)
+	if status.Code(err) == codes.NotFound {
+		return false, nil // Nothing to delete
+	}
+	if err != nil {
+		return false, err
+	}
+	if before.DeletedAt != 0 {
+		return false, nil // Already in the trash
+	}
+	if in.Version != 0 && before.Version != in.Version {
+		return false, versionMismatch(before, in.Version)
+	}
 
-	query := `DELETE FROM tasks WHERE  taskId = ?`
-	res, err := s.Db.Exec(query, in.Task.TaskId)
+	// Soft delete query, tasks already in the trash are left untouched
+	query := `UPDATE tasks SET deletedAt = ?, version = version + 1, updateTime = ? WHERE taskId = ? AND deletedAt = 0`
+	now := tx.now.Unix()
+	res, err := tx.Exec(query, now, now, in.TaskId)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
 	rowsAffected, err := res.RowsAffected()
+	if err != nil || rowsAffected == 0 {
+		return false, err
+	}
+
+	task, err := getTask(tx, in.TaskId)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
+	return true, recordHistory(ctx, tx, task.TaskId, ActionDelete, before, task)
+}
+
+// completedAt returns when a task was completed once it is changed from
+// before to after, keeping the time of a task that was already complete.
+func completedAt(before, after *pb.Task, now time.Time) int64 {
+	switch {
+	case !after.Complete:
+		return 0
+	case before != nil && before.Complete:
+		return before.CompletedAt
+	}
+	return now.Unix()
+}
 
-	return &pb.DeleteTaskResponse{Success: rowsAffected == 1}, nil
+// versionMismatch reports that an update was based on an outdated version of the task.
+func versionMismatch(current *pb.Task, version int64) error {
+	return status.Errorf(codes.Aborted, "task %d was modified: it is at version %d, the change was based on version %d",
+		current.TaskId, current.Version, version)
 }
 
-// ListTask retrieves all the tasks, filtered by dates, status, etc.
+// ListTask implements the ListTask RPC on top of ListTasks
+func (s *Server) ListTask(ctx context.Context, in *pb.TaskRequest) (*pb.ListTaskResponse, error) {
+	return s.ListTasks(ctx, in)
+}
+
+// ListTasks retrieves all the tasks, filtered by dates, status, etc.
+// Tasks in the trash are skipped unless includeDeleted is set, and tasks that
+// have not started yet unless includeNotStarted is set. overdueOnly keeps the
+// tasks the overdue job flagged.
 func (s *Server) ListTasks(ctx context.Context, in *pb.TaskRequest) (*pb.ListTaskResponse, error) {
 	var whereClause []string
-	if len(strings.TrimSpace(in.Task.Title)) != 0 {
-		whereClause = append(whereClause, "title LIKE %"+strings.TrimSpace(in.Task.Title)+"%")
+	var args []any
+	if title := strings.TrimSpace(in.GetTask().GetTitle()); len(title) != 0 {
+		whereClause = append(whereClause, "title LIKE ?")
+		args = append(args, "%"+title+"%")
 	}
 
-	if len(strings.TrimSpace(in.Task.Description)) != 0 {
-		whereClause = append(whereClause, "description LIKE %"+strings.TrimSpace(in.Task.Description)+"%")
+	if description := strings.TrimSpace(in.GetTask().GetDescription()); len(description) != 0 {
+		whereClause = append(whereClause, "description LIKE ?")
+		args = append(args, "%"+description+"%")
 	}
 
-	if len(strings.TrimSpace(in.Task.ExitCriteria)) != 0 {
-		whereClause = append(whereClause, "exitCriteria LIKE %"+strings.TrimSpace(in.Task.ExitCriteria)+"%")
+	if exitCriteria := strings.TrimSpace(in.GetTask().GetExitCriteria()); len(exitCriteria) != 0 {
+		whereClause = append(whereClause, "exitCriteria LIKE ?")
+		args = append(args, "%"+exitCriteria+"%")
 	}
 
-	if in.Task.Deadline > 0 {
-		whereClause = append(whereClause, "deadline = "+fmt.Sprintf("%d", in.Task.Deadline))
+	if in.GetTask().GetDeadline() > 0 {
+		whereClause = append(whereClause, "deadline = ?")
+		args = append(args, in.Task.Deadline)
 	}
 
-	if in.Task.Complete {
+	if in.GetTask().GetComplete() {
 		whereClause = append(whereClause, "complete = 1")
 	}
 
-	query := "SELECT * FROM tasks "
-	if len(whereClause) > 0 {
-		query += "WHERE "
+	if list := strings.TrimSpace(in.GetTask().GetList()); len(list) != 0 {
+		whereClause = append(whereClause, "list = ?")
+		args = append(args, list)
 	}
-	query = query + strings.Join(whereClause, " AND ") + " ORDER BY taskId ASC"
-	rows, err := s.Db.Query(query)
-	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to query tasks: %v", err))
+
+	// Tasks must have every tag of the filter, tags are stored separated by spaces
+	for _, tag := range normalizeTags(in.GetTask().GetTags()) {
+		whereClause = append(whereClause, "' ' || tags || ' ' LIKE ?")
+		args = append(args, "% "+tag+" %")
 	}
-	defer rows.Close() // Ensure the rows are properly closed when done.
 
-	var tasks []*pb.Task
-	for rows.Next() {
-		var taskId int
-		var title, description, exitCriteria string
-		var deadline int64
-		var complete bool
+	if priority := in.GetTask().GetPriority(); priority != pb.Priority_PRIORITY_UNSPECIFIED {
+		whereClause = append(whereClause, "priority = ?")
+		args = append(args, priority)
+	}
 
-		// Adjust the scan parameters based on your database schema
-		if err := rows.Scan(&taskId, &title, &description, &deadline, &exitCriteria, &complete); err != nil {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to scan row: %v", err))
-		}
+	if in.GetOverdueOnly() {
+		whereClause = append(whereClause, "overdueAt != 0")
+	}
 
-		tasks = append(tasks, &pb.Task{
-			TaskId:       int64(taskId),
-			Title:        title,
-			Description:  description,
-			ExitCriteria: exitCriteria,
-			Deadline:     deadline,
-			Complete:     complete,
-		})
+	if !in.GetIncludeDeleted() {
+		whereClause = append(whereClause, "deletedAt = 0")
+	}
+
+	if !in.GetIncludeNotStarted() {
+		whereClause = append(whereClause, "startAt <= ?")
+		args = append(args, s.now().Unix())
+	}
+
+	query := "SELECT " + taskColumns + " FROM tasks "
+	if len(whereClause) > 0 {
+		query += "WHERE "
+	}
+	query = query + strings.Join(whereClause, " AND ") + " ORDER BY taskId ASC"
+	tasks, err := queryTasks(s.Db, query, args...)
+	if err != nil {
+		return nil, err
 	}
 	return &pb.ListTaskResponse{Tasks: tasks}, nil
 }
 
 // Get Completed Tasks.
 func (s *Server) CompletedTasks(ctx context.Context, in *pb.TaskRequest) (*pb.ListTaskResponse, error) {
-	rows, err := s.Db.Query("SELECT * FROM tasks WHERE complete = 1")
+	completedTasks, err := queryTasks(s.Db, "SELECT "+taskColumns+" FROM tasks WHERE complete = 1 AND deletedAt = 0")
 	if err != nil {
-		log.Fatalf("Failed to query tasks: %v", err)
-	}
-	defer rows.Close() // Ensure the rows are properly closed when done.
-
-	var completedTasks []*pb.Task
-	for rows.Next() {
-		var taskId int
-		var title, description, exitCriteria string
-		var deadline int64
-		var complete bool
-
-		// Adjust the scan parameters based on your database schema
-		if err := rows.Scan(&taskId, &title, &description, &deadline, &exitCriteria, &complete); err != nil {
-			log.Fatalf("Failed to scan row: %v", err)
-		}
-
-		completedTasks = append(completedTasks, &pb.Task{
-			TaskId:       int64(taskId),
-			Title:        title,
-			Description:  description,
-			ExitCriteria: exitCriteria,
-			Deadline:     deadline,
-			Complete:     complete,
-		})
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("Error encountered during iteration: %v", err))
+		return nil, err
 	}
 	return &pb.ListTaskResponse{Tasks: completedTasks}, nil
 }
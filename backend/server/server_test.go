@@ -8,13 +8,16 @@ import (
 	"testing"
 	"time"
 
+	"taskify/backend/clock"
 	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts" // Import cmpopts for IgnoreFields
 	_ "github.com/mattn/go-sqlite3"        // SQLite driver
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func initializeTestingDatabase(t *testing.T) *sql.DB {
@@ -23,9 +26,10 @@ func initializeTestingDatabase(t *testing.T) *sql.DB {
 	if err != nil {
 		t.Fatalf("Failed to open test database: %v", err)
 	}
+	db.SetMaxOpenConns(1) // Every new connection would open a separate empty in-memory database
 
 	// Read the schema from the init.sql file
-	schema, err := os.ReadFile("../database/init.sql") // Adjust the path as needed
+	schema, err := os.ReadFile("../../database/init.sql") // Adjust the path as needed
 	if err != nil {
 		t.Fatalf("Failed to read init.sql: %v", err)
 	}
@@ -38,6 +42,17 @@ func initializeTestingDatabase(t *testing.T) *sql.DB {
 	return db
 }
 
+// newFakeClockServer returns a server on a fake clock, with the validator on the same clock.
+func newFakeClockServer(t *testing.T) (*Server, *clock.Fake) {
+	t.Helper()
+	fake := clock.NewFake(time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC))
+	validator, err := validators.New(validators.DefaultConfig(), validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	return &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}, fake
+}
+
 func TestCreateTask(t *testing.T) {
 	ctx := context.Background()
 	db := initializeTestingDatabase(t)
@@ -69,7 +84,7 @@ func TestCreateTask(t *testing.T) {
 				ExitCriteria: "Finish it",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.NotFound, "Description was missing"),
+			expectedError: validators.FieldError("description", "description is empty"),
 		},
 		{
 			name: "missing_title",
@@ -79,7 +94,7 @@ func TestCreateTask(t *testing.T) {
 				ExitCriteria: "Finish it",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.NotFound, "Title was missing"),
+			expectedError: validators.FieldError("title", "title is empty"),
 		},
 		{
 			name: "missing_exit_criteria",
@@ -89,7 +104,7 @@ func TestCreateTask(t *testing.T) {
 				Deadline:    time.Now().Add(1 * time.Hour).Unix(),
 				Complete:    false,
 			},
-			expectedError: status.Error(codes.NotFound, "Exit Criteria was missing"),
+			expectedError: validators.FieldError("exitCriteria", "exit criteria is empty"),
 		},
 		{
 			name: "missing_deadline",
@@ -99,7 +114,7 @@ func TestCreateTask(t *testing.T) {
 				ExitCriteria: "Finish it",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.NotFound, "Deadline was missing"),
+			expectedError: validators.FieldError("deadline", "deadline is missing"),
 		},
 		{
 			name: "deadline_passed",
@@ -110,7 +125,7 @@ func TestCreateTask(t *testing.T) {
 				ExitCriteria: "Finish it",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.InvalidArgument, "Deadline must be in the future"),
+			expectedError: validators.FieldError("deadline", "deadline is set in the past"),
 		},
 	}
 
@@ -128,7 +143,7 @@ func TestCreateTask(t *testing.T) {
 					}
 
 				} else {
-					if diff := cmp.Diff(req.Task, res.Task, cmpopts.IgnoreFields(pb.Task{}, "TaskId", "Deadline"), cmpopts.IgnoreUnexported(pb.Task{})); diff != "" {
+					if diff := cmp.Diff(req.Task, res.Task, cmpopts.IgnoreFields(pb.Task{}, "TaskId", "Deadline", "DeadlineTime", "Version", "UpdateTime", "CreatedAt"), cmpopts.IgnoreUnexported(pb.Task{})); diff != "" {
 						t.Errorf("Task could not be created (+want,-got) %v", diff)
 					}
 				}
@@ -162,7 +177,7 @@ func TestCreateTask_DuplicateTask(t *testing.T) {
 	}
 
 	_, err = testServer.CreateTask(ctx, req) // Trying to store the task for a second time
-	if !strings.Contains(err.Error(), "UNIQUE constraint failed") {
+	if status.Code(err) != codes.AlreadyExists {
 		t.Fatalf("There's a bigger issue trying to create a task in the DB %v", err)
 	}
 
@@ -372,7 +387,7 @@ func TestUpdate(t *testing.T) {
 				ExitCriteria: "Finish it",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.NotFound, "Title was missing"),
+			expectedError: validators.FieldError("title", "title is empty"),
 		},
 		{
 			name: "description_missing",
@@ -382,7 +397,7 @@ func TestUpdate(t *testing.T) {
 				ExitCriteria: "Finish it",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.NotFound, "Description was missing"),
+			expectedError: validators.FieldError("description", "description is empty"),
 		},
 		{
 			name: "deadline_missing",
@@ -392,7 +407,7 @@ func TestUpdate(t *testing.T) {
 				ExitCriteria: "Finish it",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.NotFound, "Deadline was missing"),
+			expectedError: validators.FieldError("deadline", "deadline is missing"),
 		},
 		{
 			name: "exit_criteria_missing",
@@ -402,7 +417,7 @@ func TestUpdate(t *testing.T) {
 				Deadline:    time.Now().Add(1 * time.Hour).Unix(),
 				Complete:    false,
 			},
-			expectedError: status.Error(codes.NotFound, "Exit Criteria was missing"),
+			expectedError: validators.FieldError("exitCriteria", "exit criteria is empty"),
 		},
 		{
 			name: "no_changes",
@@ -424,7 +439,7 @@ func TestUpdate(t *testing.T) {
 				ExitCriteria: "Finish it",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.InvalidArgument, "Deadline must be in the future"),
+			expectedError: validators.FieldError("deadline", "deadline is set in the past"),
 		},
 	}
 	for _, tc := range testCases {
@@ -441,6 +456,7 @@ func TestUpdate(t *testing.T) {
 				t.Fatalf("The task could not be created: %v", err)
 			}
 			tc.task.TaskId = res.Task.TaskId
+			tc.task.Version = res.Task.Version
 			updateReq := &pb.TaskRequest{
 				Task: tc.task,
 			}
@@ -450,7 +466,7 @@ func TestUpdate(t *testing.T) {
 					t.Fatalf("Task %d:%s could not be updated: %v expected %v", updateReq.Task.TaskId, updateReq.Task.Title, err, tc.expectedError)
 				}
 			} else {
-				if diff := cmp.Diff(tc.task, resUp.Task, cmpopts.IgnoreUnexported(pb.Task{})); diff != "" {
+				if diff := cmp.Diff(tc.task, resUp.Task, cmpopts.IgnoreFields(pb.Task{}, "Version", "UpdateTime", "DeadlineTime"), cmpopts.IgnoreUnexported(pb.Task{})); diff != "" {
 					t.Errorf("Update error (+want,-got):%v", diff)
 				}
 			}
@@ -459,6 +475,46 @@ func TestUpdate(t *testing.T) {
 
 }
 
+func TestUpdate_Versions(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	res, err := testServer.CreateTask(ctx, &pb.TaskRequest{
+		Task: &pb.Task{Title: "Test Task", Description: "This is the task", Deadline: time.Now().Add(1 * time.Hour).Unix(), ExitCriteria: "Finish it"},
+	})
+	if err != nil {
+		t.Fatalf("The task could not be created: %v", err)
+	}
+	if res.Task.Version != 1 {
+		t.Errorf("New task has version %d, want 1", res.Task.Version)
+	}
+
+	first := proto.Clone(res.Task).(*pb.Task)
+	first.Title = "First Writer"
+	updated, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: first})
+	if err != nil {
+		t.Fatalf("First update failed: %v", err)
+	}
+	if updated.Task.Version != 2 {
+		t.Errorf("Updated task has version %d, want 2", updated.Task.Version)
+	}
+
+	second := proto.Clone(res.Task).(*pb.Task)
+	second.Title = "Second Writer"
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: second}); status.Code(err) != codes.Aborted {
+		t.Errorf("Update based on a stale version: got %v, want Aborted", err)
+	}
+
+	second.Version = 0
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: second}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Update without a version: got %v, want InvalidArgument", err)
+	}
+
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: res.Task}); status.Code(err) != codes.Aborted {
+		t.Errorf("Delete based on a stale version: got %v, want Aborted", err)
+	}
+}
+
 func TestListTask(t *testing.T) {
 	ctx := context.Background()
 	testServer := Server{
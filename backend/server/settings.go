@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+	_ "time/tzdata" // Time zones work even where the system has no zoneinfo
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// userSettings loads the settings of user, the defaults if they never changed any.
+func userSettings(q queryer, user string) (*pb.UserSettings, error) {
+	settings := &pb.UserSettings{User: user, SuggestionWeights: DefaultSuggestionWeights()}
+	var weights string
+	err := q.QueryRow("SELECT timeZone, suggestionWeights FROM user_settings WHERE user = ?", user).Scan(&settings.TimeZone, &weights)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "reading the settings of %s: %v", user, err)
+	}
+	if weights != "" {
+		if err := protojson.Unmarshal([]byte(weights), settings.SuggestionWeights); err != nil {
+			return nil, status.Errorf(codes.Internal, "reading the suggestion weights of %s: %v", user, err)
+		}
+	}
+	return settings, nil
+}
+
+// userLocation returns the time zone of the user making the request.
+func userLocation(ctx context.Context, q queryer) (*time.Location, error) {
+	settings, err := userSettings(q, ActorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(settings.TimeZone)
+	if err != nil {
+		return time.UTC, nil // A zone removed from the tz database since it was saved
+	}
+	return loc, nil
+}
+
+// UserLocation returns the time zone of the user making the request, UTC if
+// they have not chosen one.
+func (s *Server) UserLocation(ctx context.Context) (*time.Location, error) {
+	return userLocation(ctx, s.Db)
+}
+
+// GetUserSettings returns the settings of the caller
+func (s *Server) GetUserSettings(ctx context.Context, in *pb.UserSettings) (*pb.UserSettings, error) {
+	return userSettings(s.Db, ActorFromContext(ctx))
+}
+
+// UpdateUserSettings replaces the settings of the caller. Settings without
+// suggestion weights keep the stored ones.
+func (s *Server) UpdateUserSettings(ctx context.Context, in *pb.UserSettings) (*pb.UserSettings, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "UserSettings is nil")
+	}
+	timeZone := strings.TrimSpace(in.TimeZone)
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return nil, validators.FieldError("timeZone", "unknown time zone "+timeZone)
+	}
+	var weights []byte
+	if in.SuggestionWeights != nil {
+		if err := validateWeights(in.SuggestionWeights); err != nil {
+			return nil, err
+		}
+		var err error
+		if weights, err = protojson.Marshal(in.SuggestionWeights); err != nil {
+			return nil, status.Errorf(codes.Internal, "encoding the suggestion weights: %v", err)
+		}
+	}
+
+	user := ActorFromContext(ctx)
+	query := `INSERT INTO user_settings (user, timeZone, suggestionWeights, updatedAt) VALUES (?, ?, ?, ?)
+		ON CONFLICT (user) DO UPDATE SET timeZone = excluded.timeZone, updatedAt = excluded.updatedAt,
+		suggestionWeights = CASE WHEN excluded.suggestionWeights = '' THEN suggestionWeights ELSE excluded.suggestionWeights END`
+	if _, err := s.Db.Exec(query, user, timeZone, string(weights), s.now().Unix()); err != nil {
+		return nil, status.Errorf(codes.Internal, "saving the settings of %s: %v", user, err)
+	}
+	return userSettings(s.Db, user)
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUserSettings(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	alice := WithActor(context.Background(), "alice")
+
+	settings, err := testServer.GetUserSettings(alice, &pb.UserSettings{})
+	if err != nil || settings.TimeZone != "" {
+		t.Fatalf("GetUserSettings() = %v, %v, want no time zone", settings, err)
+	}
+	if _, err := testServer.UpdateUserSettings(alice, &pb.UserSettings{TimeZone: "Europe/Paris"}); err != nil {
+		t.Fatalf("UpdateUserSettings: %v", err)
+	}
+	loc, err := testServer.UserLocation(alice)
+	if err != nil || loc.String() != "Europe/Paris" {
+		t.Errorf("UserLocation() = %v, %v, want Europe/Paris", loc, err)
+	}
+
+	// Settings belong to the user who made them
+	if loc, _ := testServer.UserLocation(WithActor(context.Background(), "bob")); loc != time.UTC {
+		t.Errorf("Another user got the time zone %v, want UTC", loc)
+	}
+
+	_, err = testServer.UpdateUserSettings(alice, &pb.UserSettings{TimeZone: "Mars/Olympus_Mons"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateUserSettings(unknown zone) returned %v, want InvalidArgument", err)
+	}
+}
+
+func TestCreateTask_AllDayDeadline(t *testing.T) {
+	start := time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC)
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: clock.NewFake(start)}
+	alice := WithActor(context.Background(), "alice")
+	if _, err := testServer.UpdateUserSettings(alice, &pb.UserSettings{TimeZone: "America/New_York"}); err != nil {
+		t.Fatalf("UpdateUserSettings: %v", err)
+	}
+
+	res, err := testServer.CreateTask(alice, &pb.TaskRequest{Task: &pb.Task{
+		Title:        "All Day Task",
+		Description:  "Due some time that day",
+		DeadlineDate: "2030-03-02",
+		ExitCriteria: "Done",
+	}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	newYork, _ := time.LoadLocation("America/New_York")
+	want := time.Date(2030, 3, 2, 23, 59, 59, 0, newYork)
+	if got := time.Unix(res.Task.Deadline, 0); !got.Equal(want) {
+		t.Errorf("Deadline = %v, want the end of the day in New York, %v", got.In(newYork), want)
+	}
+	if res.Task.DeadlineDate != "2030-03-02" || !res.Task.DeadlineTime.AsTime().Equal(want) {
+		t.Errorf("Task = %v, want the date kept and deadlineTime set", res.Task)
+	}
+
+	_, err = testServer.CreateTask(alice, &pb.TaskRequest{Task: &pb.Task{
+		Title:        "Bad Date Task",
+		Description:  "Due on a day that does not exist",
+		DeadlineDate: "2030-02-30",
+		ExitCriteria: "Done",
+	}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateTask(2030-02-30) returned %v, want InvalidArgument", err)
+	}
+}
+
+func TestCreateTask_DeadlineTime(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+		Title:        "Timestamp Task",
+		Description:  "Only sets deadlineTime",
+		DeadlineTime: timestamppb.New(deadline),
+		ExitCriteria: "Done",
+	}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	if res.Task.Deadline != deadline.Unix() {
+		t.Errorf("Deadline = %d, want %d from deadlineTime", res.Task.Deadline, deadline.Unix())
+	}
+
+	// A client that only knows the legacy field edits deadline and sends deadlineTime back unchanged
+	task := res.Task
+	task.Deadline = deadline.Add(time.Hour).Unix()
+	updated, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: task})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if updated.Task.DeadlineTime.Seconds != task.Deadline {
+		t.Errorf("deadlineTime = %v, want it to follow deadline %d", updated.Task.DeadlineTime.AsTime(), task.Deadline)
+	}
+
+	_, err = testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+		Title:        "Conflicting Task",
+		Description:  "Sets both deadlines differently",
+		Deadline:     deadline.Unix(),
+		DeadlineTime: timestamppb.New(deadline.Add(time.Hour)),
+		ExitCriteria: "Done",
+	}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateTask(disagreeing deadlines) returned %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+)
+
+// SnoozeTask hides a task from ListTasks until a later time by moving its
+// start. It is an ordinary update, so it is versioned, recorded in the
+// history and can be undone, and it cannot push the start past the deadline.
+func (s *Server) SnoozeTask(ctx context.Context, in *pb.SnoozeRequest) (*pb.TaskResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "SnoozeRequest is nil")
+	}
+	if in.Until == 0 && in.Seconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "until or seconds must be set")
+	}
+
+	var task *pb.Task
+	err := s.inTx(func(tx *txn) error {
+		until := in.Until
+		if until == 0 {
+			until = tx.now.Add(time.Duration(in.Seconds) * time.Second).Unix()
+		}
+		if until <= tx.now.Unix() {
+			return status.Error(codes.InvalidArgument, "a task can only be snoozed until a later time")
+		}
+
+		current, err := getTask(tx, in.TaskId)
+		if err != nil {
+			return err
+		}
+		if in.Version != 0 && current.Version != in.Version {
+			return versionMismatch(current, in.Version)
+		}
+		current.StartAt = until
+		task, err = s.updateTask(ctx, tx, current)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TaskResponse{Task: task}, nil
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSnoozeTask(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Now())
+	validator, err := validators.New(validators.DefaultConfig(), validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}
+	task := createTestTask(t, testServer, "Snoozed Task")
+	task.Deadline = fake.Now().Add(48 * time.Hour).Unix()
+	res, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: task})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	snoozed, err := testServer.SnoozeTask(ctx, &pb.SnoozeRequest{TaskId: task.TaskId, Version: res.Task.Version, Seconds: int64((24 * time.Hour).Seconds())})
+	if err != nil {
+		t.Fatalf("SnoozeTask: %v", err)
+	}
+	if want := fake.Now().Add(24 * time.Hour).Unix(); snoozed.Task.StartAt != want {
+		t.Errorf("StartAt = %d, want %d", snoozed.Task.StartAt, want)
+	}
+	if count := countTasks(t, testServer); count != 0 {
+		t.Errorf("ListTasks returned %d tasks, want the snoozed task hidden", count)
+	}
+	all, err := testServer.ListTasks(ctx, &pb.TaskRequest{IncludeNotStarted: true})
+	if err != nil || len(all.Tasks) != 1 {
+		t.Errorf("ListTasks(includeNotStarted) = %v, %v, want the snoozed task", all, err)
+	}
+
+	fake.Advance(24 * time.Hour)
+	if count := countTasks(t, testServer); count != 1 {
+		t.Errorf("ListTasks returned %d tasks once the task started, want 1", count)
+	}
+
+	// The start may not pass the deadline, now 24 hours away
+	_, err = testServer.SnoozeTask(ctx, &pb.SnoozeRequest{TaskId: task.TaskId, Seconds: int64((48 * time.Hour).Seconds())})
+	if _, ok := validators.FieldViolations(err)["startAt"]; status.Code(err) != codes.InvalidArgument || !ok {
+		t.Errorf("Snoozing past the deadline returned %v, want a startAt violation", err)
+	}
+
+	_, err = testServer.SnoozeTask(ctx, &pb.SnoozeRequest{TaskId: task.TaskId, Version: res.Task.Version, Seconds: 60})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("Snoozing an old version returned %v, want Aborted", err)
+	}
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+)
+
+// DefaultStatsWindows are the days GetStats reports on when none are asked for.
+var DefaultStatsWindows = []int32{1, 7, 30}
+
+// maxStatsWindow is the longest window GetStats accepts, in days.
+const maxStatsWindow = 3660
+
+// overdueSQL is true for a task row that is past its deadline and not complete.
+const overdueSQL = "(NOT complete AND deadline > 0 AND deadline < ?)"
+
+// GetStats reports how many tasks are created, completed and overdue. Every
+// figure is a SQL aggregate over the tasks that are not in the trash, so no
+// task is loaded. Tasks created before createdAt and completedAt were
+// recorded have them at 0 and are left out of the windows and lead time.
+func (s *Server) GetStats(ctx context.Context, in *pb.StatsRequest) (*pb.StatsResponse, error) {
+	windows := in.GetWindowDays()
+	if len(windows) == 0 {
+		windows = DefaultStatsWindows
+	}
+	for _, days := range windows {
+		if days <= 0 || days > maxStatsWindow {
+			return nil, status.Errorf(codes.InvalidArgument, "window of %d days is not between 1 and %d", days, maxStatsWindow)
+		}
+	}
+
+	now := s.now()
+	where, args := "deletedAt = 0", []any{}
+	if list := strings.TrimSpace(in.GetList()); list != "" {
+		where += " AND list = ?"
+		args = append(args, list)
+	}
+
+	stats := &pb.StatsResponse{GeneratedAt: now.Unix()}
+	var leadTime sql.NullFloat64
+	query := `SELECT COUNT(*), COALESCE(SUM(complete), 0), COALESCE(SUM(` + overdueSQL + `), 0),
+		COALESCE(SUM(complete AND deadline > 0 AND completedAt > 0 AND completedAt <= deadline), 0),
+		COALESCE(SUM(complete AND deadline > 0 AND completedAt > deadline), 0),
+		AVG(CASE WHEN complete AND createdAt > 0 AND completedAt >= createdAt THEN completedAt - createdAt END)
+		FROM tasks WHERE ` + where
+	err := s.Db.QueryRowContext(ctx, query, append([]any{now.Unix()}, args...)...).Scan(
+		&stats.Total, &stats.Completed, &stats.Overdue, &stats.CompletedOnTime, &stats.CompletedLate, &leadTime)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "computing the totals: %v", err)
+	}
+	stats.AverageLeadTimeSeconds = leadTime.Float64
+	if finished := stats.CompletedOnTime + stats.CompletedLate; finished > 0 {
+		stats.OnTimeRatio = float64(stats.CompletedOnTime) / float64(finished)
+	}
+
+	if stats.Estimates, err = s.estimateStats(ctx, where, args); err != nil {
+		return nil, err
+	}
+
+	for _, days := range windows {
+		window := &pb.WindowStats{Days: days}
+		since := now.Add(-time.Duration(days) * 24 * time.Hour).Unix()
+		query := `SELECT COALESCE(SUM(createdAt >= ?), 0), COALESCE(SUM(complete AND completedAt >= ?), 0) FROM tasks WHERE ` + where
+		err := s.Db.QueryRowContext(ctx, query, append([]any{since, since}, args...)...).Scan(&window.Created, &window.Completed)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "computing the %d day window: %v", days, err)
+		}
+		window.CompletedPerDay = float64(window.Completed) / float64(days)
+		if window.Created > 0 {
+			window.CompletionRate = float64(window.Completed) / float64(window.Created)
+		}
+		stats.Windows = append(stats.Windows, window)
+	}
+
+	groupArgs := append([]any{now.Unix()}, args...)
+	if stats.ByPriority, err = s.breakdown(ctx, `SELECT priority, COUNT(*), SUM(complete), SUM(`+overdueSQL+`)
+		FROM tasks WHERE `+where+` GROUP BY priority ORDER BY priority DESC`, groupArgs, func(key string) string {
+		priority, _ := strconv.Atoi(key)
+		return pb.Priority(priority).String()
+	}); err != nil {
+		return nil, err
+	}
+	if stats.ByAssignee, err = s.breakdown(ctx, `SELECT assignee, COUNT(*), SUM(complete), SUM(`+overdueSQL+`)
+		FROM tasks WHERE `+where+` GROUP BY assignee ORDER BY assignee`, groupArgs, nil); err != nil {
+		return nil, err
+	}
+
+	// Tags are stored separated by spaces, the recursive query splits them into one row per tag
+	if stats.ByTag, err = s.breakdown(ctx, `WITH RECURSIVE tagged(tag, rest, complete, overdue) AS (
+			SELECT '', tags || ' ', complete, `+overdueSQL+` FROM tasks WHERE `+where+`
+			UNION ALL
+			SELECT substr(rest, 1, instr(rest, ' ') - 1), substr(rest, instr(rest, ' ') + 1), complete, overdue
+			FROM tagged WHERE rest <> ''
+		)
+		SELECT tag, COUNT(*), SUM(complete), SUM(overdue) FROM tagged WHERE tag <> '' GROUP BY tag ORDER BY tag`, groupArgs, nil); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// estimateStats compares the estimates of the completed tasks matching where
+// with the time logged on them, leaving out the tasks without logged time.
+func (s *Server) estimateStats(ctx context.Context, where string, args []any) (*pb.EstimateStats, error) {
+	estimates := &pb.EstimateStats{}
+	query := `SELECT COUNT(*), COALESCE(SUM(estimateMinutes * 60), 0), COALESCE(SUM(trackedSeconds), 0),
+		COALESCE(SUM(trackedSeconds > estimateMinutes * 60), 0), COALESCE(SUM(trackedSeconds < estimateMinutes * 60), 0)
+		FROM tasks WHERE complete AND estimateMinutes > 0 AND trackedSeconds > 0 AND ` + where
+	err := s.Db.QueryRowContext(ctx, query, args...).Scan(&estimates.Tasks, &estimates.EstimatedSeconds, &estimates.TrackedSeconds,
+		&estimates.Underestimated, &estimates.Overestimated)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "comparing estimates: %v", err)
+	}
+	if estimates.EstimatedSeconds > 0 {
+		estimates.TrackedToEstimated = float64(estimates.TrackedSeconds) / float64(estimates.EstimatedSeconds)
+	}
+	return estimates, nil
+}
+
+// breakdown runs a query selecting a key and the total, completed and overdue
+// counts of each group. name turns the key into the name reported, if set.
+func (s *Server) breakdown(ctx context.Context, query string, args []any, name func(string) string) ([]*pb.Breakdown, error) {
+	rows, err := s.Db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "computing a breakdown: %v", err)
+	}
+	defer rows.Close()
+
+	var groups []*pb.Breakdown
+	for rows.Next() {
+		group := &pb.Breakdown{}
+		if err := rows.Scan(&group.Key, &group.Total, &group.Completed, &group.Overdue); err != nil {
+			return nil, status.Errorf(codes.Internal, "reading a breakdown: %v", err)
+		}
+		if name != nil {
+			group.Key = name(group.Key)
+		}
+		groups = append(groups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading a breakdown: %v", err)
+	}
+	return groups, nil
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetStats(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+	validator, err := validators.New(validators.DefaultConfig(), validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}
+
+	create := func(title string, deadline time.Duration, priority pb.Priority, assignee string, tags ...string) *pb.Task {
+		t.Helper()
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "Counted", ExitCriteria: "Done",
+			Deadline: fake.Now().Add(deadline).Unix(), Priority: priority, Assignee: assignee, Tags: tags,
+		}})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", title, err)
+		}
+		return res.Task
+	}
+	complete := func(task *pb.Task) {
+		t.Helper()
+		task.Complete = true
+		if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+			t.Fatalf("UpdateTask(%s): %v", task.Title, err)
+		}
+	}
+
+	early := create("Early", 48*time.Hour, pb.Priority_HIGH, "alice", "home", "finance")
+	late := create("Late", time.Hour, pb.Priority_LOW, "bob", "home")
+	create("Overdue", 2*time.Hour, pb.Priority_HIGH, "alice")
+	trashed := create("Trashed", time.Hour, pb.Priority_URGENT, "carol", "home")
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: trashed}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	fake.Advance(24 * time.Hour)
+	complete(early)
+	complete(late)
+	fake.Advance(7 * 24 * time.Hour) // Everything now happened more than a week ago
+	create("Recent", 24*time.Hour, pb.Priority_PRIORITY_UNSPECIFIED, "")
+
+	stats, err := testServer.GetStats(ctx, &pb.StatsRequest{WindowDays: []int32{1, 30}})
+	if err != nil {
+		t.Fatalf("GetStats: %v", err)
+	}
+	want := &pb.StatsResponse{
+		Windows: []*pb.WindowStats{
+			{Days: 1, Created: 1},
+			{Days: 30, Created: 4, Completed: 2, CompletedPerDay: 2.0 / 30, CompletionRate: 0.5},
+		},
+		Total:                  4,
+		Completed:              2,
+		Overdue:                1,
+		CompletedOnTime:        1,
+		CompletedLate:          1,
+		OnTimeRatio:            0.5,
+		AverageLeadTimeSeconds: (24 * time.Hour).Seconds(),
+		ByTag: []*pb.Breakdown{
+			{Key: "finance", Total: 1, Completed: 1},
+			{Key: "home", Total: 2, Completed: 2},
+		},
+		ByPriority: []*pb.Breakdown{
+			{Key: "HIGH", Total: 2, Completed: 1, Overdue: 1},
+			{Key: "LOW", Total: 1, Completed: 1},
+			{Key: "PRIORITY_UNSPECIFIED", Total: 1},
+		},
+		ByAssignee: []*pb.Breakdown{
+			{Key: "", Total: 1},
+			{Key: "alice", Total: 2, Completed: 1, Overdue: 1},
+			{Key: "bob", Total: 1, Completed: 1},
+		},
+		GeneratedAt: fake.Now().Unix(),
+		Estimates:   &pb.EstimateStats{},
+	}
+	opts := []cmp.Option{
+		cmpopts.IgnoreUnexported(pb.StatsResponse{}, pb.WindowStats{}, pb.Breakdown{}, pb.EstimateStats{}),
+		cmpopts.EquateApprox(0, 1e-9),
+	}
+	if diff := cmp.Diff(want, stats, opts...); diff != "" {
+		t.Errorf("GetStats (-want,+got):%v", diff)
+	}
+
+	_, err = testServer.GetStats(ctx, &pb.StatsRequest{WindowDays: []int32{0}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetStats(0 days) returned %v, want InvalidArgument", err)
+	}
+}
+
+func TestCompletedAt(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Now())
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake}
+	task := createTestTask(t, testServer, "Completed Task")
+	if task.CreatedAt != fake.Now().Unix() || task.CompletedAt != 0 {
+		t.Fatalf("New task has createdAt %d and completedAt %d", task.CreatedAt, task.CompletedAt)
+	}
+
+	fake.Advance(time.Hour)
+	task.Complete = true
+	res, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: task})
+	if err != nil || res.Task.CompletedAt != fake.Now().Unix() {
+		t.Fatalf("UpdateTask(complete) = %v, %v, want completedAt now", res, err)
+	}
+
+	// Editing a complete task keeps the time it was completed, whatever the client sends
+	completedAt := res.Task.CompletedAt
+	fake.Advance(time.Hour)
+	res.Task.Title = "Renamed Task"
+	res.Task.CompletedAt = 0
+	renamed, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: res.Task})
+	if err != nil {
+		t.Fatalf("UpdateTask(rename): %v", err)
+	}
+	if renamed.Task.CompletedAt != completedAt {
+		t.Errorf("completedAt = %d after a rename, want %d", renamed.Task.CompletedAt, completedAt)
+	}
+
+	renamed.Task.Complete = false
+	reopened, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: renamed.Task})
+	if err != nil || reopened.Task.CompletedAt != 0 {
+		t.Errorf("UpdateTask(reopen) = %v, %v, want completedAt cleared", reopened, err)
+	}
+
+	completed, err := testServer.CompletedTasks(ctx, &pb.TaskRequest{})
+	if err != nil || len(completed.Tasks) != 0 {
+		t.Errorf("CompletedTasks() = %v, %v, want none", completed, err)
+	}
+}
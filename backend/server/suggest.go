@@ -0,0 +1,229 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// DefaultSuggestions is how many suggestions SuggestNextTasks returns when no limit is given.
+const DefaultSuggestions = 5
+
+// maxSuggestions is the most suggestions SuggestNextTasks returns at once.
+const maxSuggestions = 50
+
+// maxWeight is the largest suggestion weight a user can set.
+const maxWeight = 100
+
+// DefaultSuggestionWeights are the weights of users who have not tuned theirs.
+func DefaultSuggestionWeights() *pb.SuggestionWeights {
+	return &pb.SuggestionWeights{Deadline: 3, Priority: 2, Unblocks: 1.5, Age: 0.5, Effort: 1}
+}
+
+// weightsByName lists the weights in the order factors are reported.
+func weightsByName(w *pb.SuggestionWeights) []struct {
+	name   string
+	weight float64
+} {
+	return []struct {
+		name   string
+		weight float64
+	}{
+		{"deadline", w.Deadline}, {"priority", w.Priority}, {"unblocks", w.Unblocks}, {"age", w.Age}, {"effort", w.Effort},
+	}
+}
+
+// validateWeights keeps every weight between 0 and maxWeight, with at least one above 0.
+func validateWeights(w *pb.SuggestionWeights) error {
+	violations := &validators.Violations{}
+	positive := false
+	for _, factor := range weightsByName(w) {
+		if math.IsNaN(factor.weight) || factor.weight < 0 || factor.weight > maxWeight {
+			violations.Add("suggestionWeights."+factor.name, fmt.Sprintf("%s weight is not between 0 and %d", factor.name, maxWeight))
+		}
+		positive = positive || factor.weight > 0
+	}
+	if !positive {
+		violations.Add("suggestionWeights", "at least one weight must be above 0")
+	}
+	return violations.Err()
+}
+
+// SuggestNextTasks ranks the open tasks that can be worked on now: started,
+// not in the trash and waiting for no open task. Each factor scores a task from 0
+// to 1 and the score is their sum weighted by the caller's weights, so the
+// factors returned with each suggestion add up to its score.
+func (s *Server) SuggestNextTasks(ctx context.Context, in *pb.SuggestRequest) (*pb.SuggestResponse, error) {
+	limit := int(in.GetLimit())
+	switch {
+	case limit < 0 || limit > maxSuggestions:
+		return nil, validators.FieldError("limit", fmt.Sprintf("limit is not between 0 and %d", maxSuggestions))
+	case limit == 0:
+		limit = DefaultSuggestions
+	}
+	if in.GetAvailableMinutes() < 0 {
+		return nil, validators.FieldError("availableMinutes", "available time is negative")
+	}
+	settings, err := userSettings(s.Db, ActorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := queryTasks(s.Db, "SELECT "+taskColumns+" FROM tasks WHERE deletedAt = 0 AND complete = 0")
+	if err != nil {
+		return nil, err
+	}
+	isOpen, dependents := map[int64]bool{}, map[int64]int{}
+	for _, task := range open {
+		isOpen[task.TaskId] = true
+	}
+	for _, task := range open {
+		for _, id := range task.DependsOn {
+			if isOpen[id] {
+				dependents[id]++
+			}
+		}
+	}
+
+	now := s.now()
+	list := strings.TrimSpace(in.GetList())
+	response := &pb.SuggestResponse{Weights: settings.SuggestionWeights}
+	for _, task := range open {
+		blocked := slices.ContainsFunc(task.DependsOn, func(id int64) bool { return isOpen[id] })
+		if blocked || task.StartAt > now.Unix() || (list != "" && task.List != list) {
+			continue
+		}
+		response.Suggestions = append(response.Suggestions, suggest(task, dependents[task.TaskId], in.GetAvailableMinutes(), settings.SuggestionWeights, now))
+	}
+
+	slices.SortFunc(response.Suggestions, func(a, b *pb.Suggestion) int {
+		if a.Score != b.Score {
+			return cmp.Compare(b.Score, a.Score)
+		}
+		if a.Task.Deadline != b.Task.Deadline && (a.Task.Deadline == 0 || b.Task.Deadline == 0) {
+			return cmp.Compare(b.Task.Deadline, a.Task.Deadline) // Tasks without a deadline last
+		}
+		return cmp.Or(cmp.Compare(a.Task.Deadline, b.Task.Deadline), cmp.Compare(a.Task.TaskId, b.Task.TaskId))
+	})
+	if len(response.Suggestions) > limit {
+		response.Suggestions = response.Suggestions[:limit]
+	}
+	return response, nil
+}
+
+// suggest scores a task that dependents open tasks wait for.
+func suggest(task *pb.Task, dependents int, availableMinutes int32, weights *pb.SuggestionWeights, now time.Time) *pb.Suggestion {
+	values := map[string]float64{}
+	reasons := map[string]string{}
+
+	if task.Deadline != 0 {
+		left := time.Unix(task.Deadline, 0).Sub(now)
+		if left <= 0 {
+			values["deadline"], reasons["deadline"] = 1, "overdue"
+		} else {
+			values["deadline"], reasons["deadline"] = 1/(1+left.Hours()/24), "due in "+formatSpan(left)
+		}
+	}
+
+	switch task.Priority {
+	case pb.Priority_URGENT:
+		values["priority"] = 1
+	case pb.Priority_HIGH:
+		values["priority"] = 0.75
+	case pb.Priority_MEDIUM:
+		values["priority"] = 0.5
+	case pb.Priority_LOW:
+		values["priority"] = 0.25
+	}
+	if task.Priority != pb.Priority_PRIORITY_UNSPECIFIED {
+		reasons["priority"] = strings.ToLower(task.Priority.String()) + " priority"
+	}
+
+	if dependents > 0 {
+		values["unblocks"] = 1 - 1/float64(1+dependents)
+		reasons["unblocks"] = fmt.Sprintf("unblocks %d %s", dependents, plural(dependents, "task"))
+	}
+
+	if task.CreatedAt != 0 && now.Unix() > task.CreatedAt {
+		days := now.Sub(time.Unix(task.CreatedAt, 0)).Hours() / 24
+		values["age"] = days / (days + 7)
+		reasons["age"] = "open for " + formatSpan(now.Sub(time.Unix(task.CreatedAt, 0)))
+	}
+
+	estimate := float64(task.EstimateMinutes)
+	switch {
+	case task.EstimateMinutes == 0:
+		values["effort"] = 0.5 // Unknown, neither quick nor long
+	case availableMinutes == 0:
+		values["effort"], reasons["effort"] = 60/(60+estimate), fmt.Sprintf("takes about %s", formatSpan(time.Duration(estimate)*time.Minute))
+	case task.EstimateMinutes <= availableMinutes:
+		values["effort"] = 1 - 0.5*estimate/float64(availableMinutes)
+		reasons["effort"] = fmt.Sprintf("fits in the %s available", formatSpan(time.Duration(availableMinutes)*time.Minute))
+	}
+
+	suggestion := &pb.Suggestion{Task: task}
+	for _, factor := range weightsByName(weights) {
+		suggestion.Factors = append(suggestion.Factors, &pb.ScoreFactor{Name: factor.name, Value: values[factor.name], Weight: factor.weight})
+		suggestion.Score += values[factor.name] * factor.weight
+	}
+	suggestion.Explanation = explain(suggestion.Factors, reasons)
+	return suggestion
+}
+
+// explain names the three factors that add the most to the score, in words.
+func explain(factors []*pb.ScoreFactor, reasons map[string]string) string {
+	ranked := slices.Clone(factors)
+	slices.SortStableFunc(ranked, func(a, b *pb.ScoreFactor) int {
+		return cmp.Compare(b.Value*b.Weight, a.Value*a.Weight)
+	})
+	var parts []string
+	for _, factor := range ranked {
+		if reason := reasons[factor.Name]; reason != "" && factor.Value*factor.Weight > 0 && len(parts) < 3 {
+			parts = append(parts, reason)
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return "No deadline, priority or estimate to rank it by"
+	case 1:
+		return capitalize(parts[0])
+	}
+	return capitalize(strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1])
+}
+
+// formatSpan writes a duration in its largest whole unit, like "3 days" or "45 minutes".
+func formatSpan(d time.Duration) string {
+	switch {
+	case d >= 48*time.Hour:
+		days := int(d.Hours() / 24)
+		return fmt.Sprintf("%d %s", days, plural(days, "day"))
+	case d >= 2*time.Hour:
+		hours := int(d.Hours())
+		return fmt.Sprintf("%d %s", hours, plural(hours, "hour"))
+	}
+	minutes := max(int(d.Minutes()), 1)
+	return fmt.Sprintf("%d %s", minutes, plural(minutes, "minute"))
+}
+
+// plural adds an s to word unless n is 1.
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
+
+// capitalize upper-cases the first letter of an ASCII sentence.
+func capitalize(sentence string) string {
+	if sentence == "" {
+		return sentence
+	}
+	return strings.ToUpper(sentence[:1]) + sentence[1:]
+}
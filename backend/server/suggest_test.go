@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+func TestSuggestNextTasks(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2030, 3, 1, 9, 0, 0, 0, time.UTC))
+	validator, err := validators.New(validators.DefaultConfig(), validators.WithClock(fake))
+	if err != nil {
+		t.Fatalf("validators.New: %v", err)
+	}
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake, Validator: validator}
+	create := func(task *pb.Task) *pb.Task {
+		t.Helper()
+		task.Description, task.ExitCriteria = "Suggested", "Done"
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: task})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", task.Title, err)
+		}
+		return res.Task
+	}
+	suggested := func(in *pb.SuggestRequest) (titles, explanations []string) {
+		t.Helper()
+		res, err := testServer.SuggestNextTasks(ctx, in)
+		if err != nil {
+			t.Fatalf("SuggestNextTasks: %v", err)
+		}
+		for _, suggestion := range res.Suggestions {
+			titles = append(titles, suggestion.Task.Title)
+			explanations = append(explanations, suggestion.Explanation)
+		}
+		return titles, explanations
+	}
+
+	create(&pb.Task{Title: "Soon", Deadline: fake.Now().Add(time.Hour).Unix(), Priority: pb.Priority_HIGH})
+	later := create(&pb.Task{Title: "Later", Deadline: fake.Now().Add(10 * 24 * time.Hour).Unix(), Priority: pb.Priority_LOW})
+	create(&pb.Task{Title: "Blocked", Deadline: fake.Now().Add(30 * 24 * time.Hour).Unix(), Priority: pb.Priority_URGENT, DependsOn: []int64{later.TaskId}})
+	create(&pb.Task{Title: "Snoozed", Deadline: fake.Now().Add(30 * 24 * time.Hour).Unix(), Priority: pb.Priority_URGENT, StartAt: fake.Now().Add(time.Hour).Unix()})
+	create(&pb.Task{Title: "Someday", Deadline: fake.Now().Add(60 * 24 * time.Hour).Unix(), EstimateMinutes: 240})
+
+	titles, explanations := suggested(&pb.SuggestRequest{})
+	if diff := cmp.Diff([]string{"Soon", "Later", "Someday"}, titles); diff != "" {
+		t.Errorf("Suggestions (-want,+got):%v", diff)
+	}
+	wantExplanations := []string{
+		"Due in 60 minutes and high priority",
+		"Unblocks 1 task, low priority and due in 10 days",
+		"Takes about 4 hours and due in 60 days",
+	}
+	if diff := cmp.Diff(wantExplanations, explanations); diff != "" {
+		t.Errorf("Explanations (-want,+got):%v", diff)
+	}
+
+	// Completing the dependency unblocks the urgent task
+	later.Complete = true
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: later}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if titles, _ := suggested(&pb.SuggestRequest{Limit: 2}); !cmp.Equal(titles, []string{"Soon", "Blocked"}) {
+		t.Errorf("Suggestions after completing the dependency = %v, want Soon and Blocked", titles)
+	}
+
+	// Weights only on effort put the task that fits the available time first
+	_, err = testServer.UpdateUserSettings(ctx, &pb.UserSettings{SuggestionWeights: &pb.SuggestionWeights{Effort: 1}})
+	if err != nil {
+		t.Fatalf("UpdateUserSettings: %v", err)
+	}
+	if titles, _ := suggested(&pb.SuggestRequest{Limit: 1, AvailableMinutes: 300}); !cmp.Equal(titles, []string{"Someday"}) {
+		t.Errorf("Suggestions by effort = %v, want Someday", titles)
+	}
+	settings, err := testServer.UpdateUserSettings(ctx, &pb.UserSettings{TimeZone: "Europe/Paris"})
+	if err != nil {
+		t.Fatalf("UpdateUserSettings: %v", err)
+	}
+	if settings.SuggestionWeights.GetEffort() != 1 || settings.SuggestionWeights.GetDeadline() != 0 {
+		t.Errorf("Settings without weights changed them to %v", settings.SuggestionWeights)
+	}
+	for weights, want := range map[*pb.SuggestionWeights]map[string]string{
+		{Age: -1, Effort: 1}: {"suggestionWeights.age": "age weight is not between 0 and 100"},
+		{}:                   {"suggestionWeights": "at least one weight must be above 0"},
+	} {
+		_, err := testServer.UpdateUserSettings(ctx, &pb.UserSettings{SuggestionWeights: weights})
+		if diff := cmp.Diff(want, validators.FieldViolations(err)); diff != "" {
+			t.Errorf("UpdateUserSettings(%v) (-want,+got):%v", weights, diff)
+		}
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	create := func(title string, dependsOn ...int64) (*pb.Task, error) {
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "Dependent", ExitCriteria: "Done", DependsOn: dependsOn,
+			Deadline: time.Now().Add(24 * time.Hour).Unix(),
+		}})
+		return res.GetTask(), err
+	}
+	first, err := create("First")
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	second, err := create("Second", first.TaskId, first.TaskId)
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	if !cmp.Equal(second.DependsOn, []int64{first.TaskId}) {
+		t.Errorf("DependsOn = %v, want only %d", second.DependsOn, first.TaskId)
+	}
+
+	_, err = create("Third", 999)
+	if diff := cmp.Diff(map[string]string{"dependsOn": "task 999 does not exist"}, validators.FieldViolations(err)); diff != "" {
+		t.Errorf("Unknown dependency (-want,+got):%v", diff)
+	}
+
+	first.DependsOn = []int64{first.TaskId}
+	_, err = testServer.UpdateTask(ctx, &pb.TaskRequest{Task: first})
+	if diff := cmp.Diff(map[string]string{"dependsOn": "a task cannot depend on itself"}, validators.FieldViolations(err)); diff != "" {
+		t.Errorf("Self dependency (-want,+got):%v", diff)
+	}
+
+	first.DependsOn = []int64{second.TaskId}
+	_, err = testServer.UpdateTask(ctx, &pb.TaskRequest{Task: first})
+	if diff := cmp.Diff(map[string]string{"dependsOn": "the dependencies would form a cycle"}, validators.FieldViolations(err)); diff != "" {
+		t.Errorf("Cycle (-want,+got):%v", diff)
+	}
+}
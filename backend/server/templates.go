@@ -0,0 +1,387 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+const templateColumns = "templateId, name, description, list, task, createdAt"
+
+// maxTemplateTasks is the most tasks a template creates.
+const maxTemplateTasks = 50
+
+// maxTemplateDepth is how deep subtasks nest in a template, the root task at depth 0.
+const maxTemplateDepth = 5
+
+// placeholderPattern matches the placeholders of template texts, like {{name}}.
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z][A-Za-z0-9_]*)\s*\}\}`)
+
+// builtinVariables are the placeholders filled in without a value from the caller.
+var builtinVariables = []string{"date"}
+
+// templateTexts returns the texts of a template task that can hold placeholders.
+func templateTexts(node *pb.TemplateTask) []string {
+	return []string{node.Title, node.Description, node.ExitCriteria, node.Assignee}
+}
+
+// templateVariables lists the placeholders of a template tree that need a value, sorted.
+func templateVariables(node *pb.TemplateTask) []string {
+	var variables []string
+	var walk func(node *pb.TemplateTask)
+	walk = func(node *pb.TemplateTask) {
+		for _, text := range templateTexts(node) {
+			for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+				if !slices.Contains(builtinVariables, match[1]) && !slices.Contains(variables, match[1]) {
+					variables = append(variables, match[1])
+				}
+			}
+		}
+		for _, subtask := range node.Subtasks {
+			walk(subtask)
+		}
+	}
+	if node != nil {
+		walk(node)
+	}
+	slices.Sort(variables)
+	return variables
+}
+
+// fillPlaceholders replaces the placeholders of text with their values.
+// Placeholders without a value are left as they are.
+func fillPlaceholders(text string, values map[string]string) string {
+	return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		if value, ok := values[placeholderPattern.FindStringSubmatch(placeholder)[1]]; ok {
+			return value
+		}
+		return placeholder
+	})
+}
+
+// scanTemplate reads a row selected with templateColumns.
+func scanTemplate(row rowScanner) (*pb.TaskTemplate, error) {
+	template := &pb.TaskTemplate{}
+	var task string
+	if err := row.Scan(&template.TemplateId, &template.Name, &template.Description, &template.List, &task, &template.CreatedAt); err != nil {
+		return nil, err
+	}
+	template.Task = &pb.TemplateTask{}
+	if err := protojson.Unmarshal([]byte(task), template.Task); err != nil {
+		return nil, err
+	}
+	template.Variables = templateVariables(template.Task)
+	return template, nil
+}
+
+// marshalTemplateTask encodes the task tree of a template for the task column.
+func marshalTemplateTask(template *pb.TaskTemplate) (string, error) {
+	data, err := protojson.Marshal(template.Task)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "encoding template: %v", err)
+	}
+	return string(data), nil
+}
+
+// normalizeTemplate trims a template and reports every invalid field, naming
+// the tasks of the tree like "task.subtasks[0].title". Names are unique.
+func normalizeTemplate(q queryer, template *pb.TaskTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	template.Description = strings.TrimSpace(template.Description)
+	template.List = strings.TrimSpace(template.List)
+
+	violations := &validators.Violations{}
+	if template.Name == "" {
+		violations.Add("name", "set a name")
+	} else {
+		var other int64
+		err := q.QueryRow("SELECT templateId FROM task_templates WHERE name = ? AND templateId != ?", template.Name, template.TemplateId).Scan(&other)
+		switch {
+		case err == nil:
+			violations.Add("name", fmt.Sprintf("template %d is already named %q", other, template.Name))
+		case err != sql.ErrNoRows:
+			return status.Errorf(codes.Internal, "reading the templates: %v", err)
+		}
+	}
+	if template.Task == nil {
+		violations.Add("task", "set the task to create")
+		return violations.Err()
+	}
+
+	count := 0
+	var walk func(node *pb.TemplateTask, path string, depth int)
+	walk = func(node *pb.TemplateTask, path string, depth int) {
+		if count++; count == maxTemplateTasks+1 {
+			violations.Add("task", fmt.Sprintf("a template creates at most %d tasks", maxTemplateTasks))
+		}
+		node.Title = strings.TrimSpace(node.Title)
+		node.Description = strings.TrimSpace(node.Description)
+		node.ExitCriteria = strings.TrimSpace(node.ExitCriteria)
+		node.Assignee = strings.TrimSpace(node.Assignee)
+		node.Tags = normalizeTags(node.Tags)
+		if node.Title == "" {
+			violations.Add(path+".title", "set a title")
+		}
+		if node.Description == "" {
+			violations.Add(path+".description", "set a description")
+		}
+		if node.ExitCriteria == "" {
+			violations.Add(path+".exitCriteria", "set the exit criteria")
+		}
+		switch {
+		case node.DeadlineOffsetSeconds == 0:
+			violations.Add(path+".deadlineOffsetSeconds", "set when the task is due, relative to the start")
+		case node.DeadlineOffsetSeconds < 0:
+			// Tasks start now by default, the deadline would have passed
+			violations.Add(path+".deadlineOffsetSeconds", "the task must be due after the start")
+		}
+		if _, ok := pb.Priority_name[int32(node.Priority)]; !ok {
+			violations.Add(path+".priority", fmt.Sprintf("unknown priority %d", node.Priority))
+		}
+		if node.EstimateMinutes < 0 {
+			violations.Add(path+".estimateMinutes", "the estimate can't be negative")
+		}
+		if len(node.Subtasks) > 0 && depth == maxTemplateDepth {
+			violations.Add(path+".subtasks", fmt.Sprintf("subtasks nest at most %d levels deep", maxTemplateDepth))
+			return
+		}
+		for i, subtask := range node.Subtasks {
+			if subtask == nil {
+				violations.Add(fmt.Sprintf("%s.subtasks[%d]", path, i), "set the subtask")
+				continue
+			}
+			walk(subtask, fmt.Sprintf("%s.subtasks[%d]", path, i), depth+1)
+		}
+	}
+	walk(template.Task, "task", 0)
+	template.Variables = templateVariables(template.Task)
+	return violations.Err()
+}
+
+func (s *Server) getTemplate(id int64) (*pb.TaskTemplate, error) {
+	template, err := scanTemplate(s.Db.QueryRow("SELECT "+templateColumns+" FROM task_templates WHERE templateId = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "template %d not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading template %d: %v", id, err)
+	}
+	return template, nil
+}
+
+// CreateTemplate adds a template with a name no other template has.
+func (s *Server) CreateTemplate(ctx context.Context, in *pb.TemplateRequest) (*pb.TemplateResponse, error) {
+	if in == nil || in.Template == nil {
+		return nil, status.Error(codes.InvalidArgument, "Template is nil")
+	}
+	template := proto.Clone(in.Template).(*pb.TaskTemplate)
+	template.TemplateId = 0
+	if err := normalizeTemplate(s.Db, template); err != nil {
+		return nil, err
+	}
+	task, err := marshalTemplateTask(template)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "INSERT INTO task_templates (name, description, list, task, createdAt) VALUES (?, ?, ?, ?, ?)"
+	res, err := s.Db.Exec(query, template.Name, template.Description, template.List, task, s.now().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating template: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating template: %v", err)
+	}
+	template, err = s.getTemplate(id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TemplateResponse{Template: template}, nil
+}
+
+// UpdateTemplate replaces every field of a template but its creation time.
+// The tasks created from it are left as they are.
+func (s *Server) UpdateTemplate(ctx context.Context, in *pb.TemplateRequest) (*pb.TemplateResponse, error) {
+	if in == nil || in.Template == nil {
+		return nil, status.Error(codes.InvalidArgument, "Template is nil")
+	}
+	if in.Template.TemplateId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TemplateId is empty")
+	}
+	template := proto.Clone(in.Template).(*pb.TaskTemplate)
+	if err := normalizeTemplate(s.Db, template); err != nil {
+		return nil, err
+	}
+	task, err := marshalTemplateTask(template)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "UPDATE task_templates SET name = ?, description = ?, list = ?, task = ? WHERE templateId = ?"
+	res, err := s.Db.Exec(query, template.Name, template.Description, template.List, task, template.TemplateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "updating template %d: %v", template.TemplateId, err)
+	}
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "template %d not found", template.TemplateId)
+	}
+	template, err = s.getTemplate(template.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TemplateResponse{Template: template}, nil
+}
+
+// DeleteTemplate removes a template, the tasks created from it are kept.
+func (s *Server) DeleteTemplate(ctx context.Context, in *pb.TemplateRequest) (*pb.DeleteTemplateResponse, error) {
+	if in == nil || in.Template == nil {
+		return nil, status.Error(codes.InvalidArgument, "Template is nil")
+	}
+	res, err := s.Db.Exec("DELETE FROM task_templates WHERE templateId = ?", in.Template.TemplateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "deleting template %d: %v", in.Template.TemplateId, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "deleting template %d: %v", in.Template.TemplateId, err)
+	}
+	return &pb.DeleteTemplateResponse{Success: rowsAffected > 0}, nil
+}
+
+// ListTemplates returns every template sorted by name.
+func (s *Server) ListTemplates(ctx context.Context, in *pb.TemplateRequest) (*pb.ListTemplatesResponse, error) {
+	rows, err := s.Db.Query("SELECT " + templateColumns + " FROM task_templates ORDER BY name ASC")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the templates: %v", err)
+	}
+	defer rows.Close()
+
+	res := &pb.ListTemplatesResponse{}
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reading a template: %v", err)
+		}
+		res.Templates = append(res.Templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the templates: %v", err)
+	}
+	return res, nil
+}
+
+// CreateTaskFromTemplate creates every task of a template in one transaction,
+// so either the whole tree is created or none of it. Placeholders are filled
+// with the variables, {{date}} with the day of the start in the time zone of
+// the caller, and deadlines are the start plus the offsets.
+func (s *Server) CreateTaskFromTemplate(ctx context.Context, in *pb.CreateFromTemplateRequest) (*pb.CreateFromTemplateResponse, error) {
+	if in == nil || in.TemplateId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TemplateId is empty")
+	}
+	template, err := s.getTemplate(in.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := userLocation(ctx, s.Db)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	start := now
+	if in.Start != 0 {
+		start = time.Unix(in.Start, 0)
+	}
+
+	violations := &validators.Violations{}
+	if start.Before(now) {
+		// The deadlines would have passed, the offsets are never negative
+		violations.Add("start", "the start can't be in the past")
+	}
+	values := map[string]string{"date": start.In(loc).Format(DeadlineDateLayout)}
+	for name, value := range in.Variables {
+		if !slices.Contains(template.Variables, name) && !slices.Contains(builtinVariables, name) {
+			violations.Add("variables."+name, fmt.Sprintf("the template has no placeholder {{%s}}", name))
+		}
+		values[name] = strings.TrimSpace(value)
+	}
+	for _, name := range template.Variables {
+		if values[name] == "" {
+			violations.Add("variables."+name, fmt.Sprintf("set a value for {{%s}}", name))
+		}
+	}
+	if err := violations.Err(); err != nil {
+		return nil, err
+	}
+
+	list := cmp.Or(strings.TrimSpace(in.List), template.List)
+	res := &pb.CreateFromTemplateResponse{}
+	err = s.inTx(func(tx *txn) error {
+		res.Tasks, err = s.createTemplateTask(ctx, tx, template.Task, "task", values, list, start)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// createTemplateTask creates the subtasks of a template task, then the task
+// depending on them. It returns the task followed by its subtasks, depth first.
+func (s *Server) createTemplateTask(ctx context.Context, tx *txn, node *pb.TemplateTask, path string, values map[string]string, list string, start time.Time) ([]*pb.Task, error) {
+	var subtasks []*pb.Task
+	var dependsOn []int64
+	for i, subtask := range node.Subtasks {
+		created, err := s.createTemplateTask(ctx, tx, subtask, fmt.Sprintf("%s.subtasks[%d]", path, i), values, list, start)
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = append(dependsOn, created[0].TaskId)
+		subtasks = append(subtasks, created...)
+	}
+
+	task, err := s.createTask(ctx, tx, &pb.Task{
+		Title:           fillPlaceholders(node.Title, values),
+		Description:     fillPlaceholders(node.Description, values),
+		ExitCriteria:    fillPlaceholders(node.ExitCriteria, values),
+		Deadline:        start.Add(time.Duration(node.DeadlineOffsetSeconds) * time.Second).Unix(),
+		Tags:            slices.Clone(node.Tags),
+		Priority:        node.Priority,
+		Assignee:        fillPlaceholders(node.Assignee, values),
+		EstimateMinutes: node.EstimateMinutes,
+		List:            list,
+		DependsOn:       dependsOn,
+	})
+	if err != nil {
+		return nil, templateTaskError(path, err)
+	}
+	return append([]*pb.Task{task}, subtasks...), nil
+}
+
+// templateTaskError names the fields of the task of a template that failed
+// validation after the task, like "task.subtasks[1].deadline".
+func templateTaskError(path string, err error) error {
+	fields := validators.FieldViolations(err)
+	if len(fields) == 0 {
+		return err
+	}
+	violations := &validators.Violations{}
+	for _, field := range slices.Sorted(maps.Keys(fields)) {
+		violations.Add(path+"."+field, fields[field])
+	}
+	return violations.Err()
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+const daySeconds = 24 * 60 * 60
+
+func onboardingTemplate() *pb.TaskTemplate {
+	return &pb.TaskTemplate{
+		Name: "Onboarding", List: "people",
+		Task: &pb.TemplateTask{
+			Title: "Onboard {{name}}", Description: "Starts {{date}}", ExitCriteria: "{{name}} ships a change", DeadlineOffsetSeconds: 14 * daySeconds,
+			Tags: []string{"#Onboarding"},
+			Subtasks: []*pb.TemplateTask{
+				{Title: "Order a laptop for {{name}}", Description: "Hardware", ExitCriteria: "Delivered", DeadlineOffsetSeconds: 2 * daySeconds, Assignee: "{{buddy}}"},
+				{Title: "Give {{name}} access", Description: "Accounts", ExitCriteria: "Logged in", DeadlineOffsetSeconds: 3 * daySeconds, Subtasks: []*pb.TemplateTask{
+					{Title: "Create the email of {{name}}", Description: "Email", ExitCriteria: "Mail works", DeadlineOffsetSeconds: daySeconds, EstimateMinutes: 15},
+				}},
+			},
+		},
+	}
+}
+
+func TestCreateTaskFromTemplate(t *testing.T) {
+	ctx := context.Background()
+	testServer, fake := newFakeClockServer(t)
+	res, err := testServer.CreateTemplate(ctx, &pb.TemplateRequest{Template: onboardingTemplate()})
+	if err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	template := res.Template
+	if diff := cmp.Diff([]string{"buddy", "name"}, template.Variables); diff != "" {
+		t.Errorf("Variables (-want,+got):%v", diff)
+	}
+
+	created, err := testServer.CreateTaskFromTemplate(ctx, &pb.CreateFromTemplateRequest{
+		TemplateId: template.TemplateId, Variables: map[string]string{"name": "Ada", "buddy": "grace"},
+	})
+	if err != nil {
+		t.Fatalf("CreateTaskFromTemplate: %v", err)
+	}
+	var got [][]any
+	for _, task := range created.Tasks {
+		got = append(got, []any{task.Title, task.Assignee, task.List, (task.Deadline - fake.Now().Unix()) / daySeconds, task.DependsOn})
+	}
+	// Subtasks are created first, so the root has the highest id
+	want := [][]any{
+		{"Onboard Ada", "", "people", int64(14), []int64{1, 3}},
+		{"Order a laptop for Ada", "grace", "people", int64(2), []int64(nil)},
+		{"Give Ada access", "", "people", int64(3), []int64{2}},
+		{"Create the email of Ada", "", "people", int64(1), []int64(nil)},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Created tasks (-want,+got):%v", diff)
+	}
+	if root := created.Tasks[0]; root.Description != "Starts 2030-03-01" || root.Tags[0] != "onboarding" {
+		t.Errorf("Root = %v, want the date filled in and the tag normalized", root)
+	}
+
+	// A task that fails validation rolls back the whole tree
+	start := fake.Now().Add(10*365*24*time.Hour - 7*24*time.Hour).Unix()
+	_, err = testServer.CreateTaskFromTemplate(ctx, &pb.CreateFromTemplateRequest{
+		TemplateId: template.TemplateId, Variables: map[string]string{"name": "Alan", "buddy": "grace"}, Start: start,
+	})
+	if _, ok := validators.FieldViolations(err)["task.deadline"]; !ok {
+		t.Errorf("CreateTaskFromTemplate starting in ten years = %v, want the onboarding deadline too far ahead", err)
+	}
+	tasks, err := testServer.ListTask(ctx, &pb.TaskRequest{})
+	if err != nil || len(tasks.Tasks) != 4 {
+		t.Errorf("ListTask = %d tasks, %v, want only the first onboarding", len(tasks.GetTasks()), err)
+	}
+
+	_, err = testServer.CreateTaskFromTemplate(ctx, &pb.CreateFromTemplateRequest{
+		TemplateId: template.TemplateId, Variables: map[string]string{"name": "Alan", "team": "infra"},
+	})
+	wantViolations := map[string]string{
+		"variables.buddy": "set a value for {{buddy}}",
+		"variables.team":  "the template has no placeholder {{team}}",
+	}
+	if diff := cmp.Diff(wantViolations, validators.FieldViolations(err)); diff != "" {
+		t.Errorf("CreateTaskFromTemplate with wrong variables (-want,+got):%v", diff)
+	}
+
+	// Starting in the past would only create tasks already past their deadline
+	_, err = testServer.CreateTaskFromTemplate(ctx, &pb.CreateFromTemplateRequest{
+		TemplateId: template.TemplateId, Variables: map[string]string{"name": "Alan", "buddy": "grace"}, Start: fake.Now().Add(-time.Hour).Unix(),
+	})
+	if diff := cmp.Diff(map[string]string{"start": "the start can't be in the past"}, validators.FieldViolations(err)); diff != "" {
+		t.Errorf("CreateTaskFromTemplate starting an hour ago (-want,+got):%v", diff)
+	}
+}
+
+func TestCreateTemplate_Invalid(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	if _, err := testServer.CreateTemplate(ctx, &pb.TemplateRequest{Template: onboardingTemplate()}); err != nil {
+		t.Fatalf("CreateTemplate: %v", err)
+	}
+	for _, tc := range []struct {
+		template *pb.TaskTemplate
+		want     map[string]string
+	}{
+		{&pb.TaskTemplate{}, map[string]string{"name": "set a name", "task": "set the task to create"}},
+		{&pb.TaskTemplate{Name: " Onboarding", Task: &pb.TemplateTask{Title: "Onboard", Description: "Welcome", ExitCriteria: "Done", DeadlineOffsetSeconds: daySeconds}}, map[string]string{
+			"name": `template 1 is already named "Onboarding"`,
+		}},
+		{&pb.TaskTemplate{Name: "Release", Task: &pb.TemplateTask{Title: "Release", Description: "Ship it", ExitCriteria: "Shipped", DeadlineOffsetSeconds: daySeconds, Subtasks: []*pb.TemplateTask{
+			{Description: "Notes", ExitCriteria: "Written", DeadlineOffsetSeconds: daySeconds},
+			{Title: "Tag", Description: "Git", ExitCriteria: "Pushed", EstimateMinutes: -5},
+		}}}, map[string]string{
+			"task.subtasks[0].title":                 "set a title",
+			"task.subtasks[1].deadlineOffsetSeconds": "set when the task is due, relative to the start",
+			"task.subtasks[1].estimateMinutes":       "the estimate can't be negative",
+		}},
+		// The tasks would fail validation when the template is used
+		{&pb.TaskTemplate{Name: "Retro", Task: &pb.TemplateTask{Title: "Retro", DeadlineOffsetSeconds: daySeconds}}, map[string]string{
+			"task.description":  "set a description",
+			"task.exitCriteria": "set the exit criteria",
+		}},
+		{&pb.TaskTemplate{Name: "Prepare", Task: &pb.TemplateTask{Title: "Prepare", Description: "Slides", ExitCriteria: "Ready", DeadlineOffsetSeconds: -daySeconds}}, map[string]string{
+			"task.deadlineOffsetSeconds": "the task must be due after the start",
+		}},
+	} {
+		_, err := testServer.CreateTemplate(ctx, &pb.TemplateRequest{Template: tc.template})
+		if diff := cmp.Diff(tc.want, validators.FieldViolations(err)); diff != "" {
+			t.Errorf("CreateTemplate(%v) (-want,+got):%v", tc.template, diff)
+		}
+	}
+}
@@ -0,0 +1,449 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+// maxTimeEntry is the longest time a manual entry can cover.
+const maxTimeEntry = 24 * time.Hour
+
+// DefaultReportDays is how many days GetTimesheet and GetFocusStats report when no start is given.
+const DefaultReportDays = 7
+
+// maxReportDays is the most days GetTimesheet and GetFocusStats report at once.
+const maxReportDays = 366
+
+// timeEntryColumns lists the time_entries columns in the order scanTimeEntry reads them.
+const timeEntryColumns = "entryId, taskId, user, startedAt, endedAt, seconds, note, manual"
+
+// scanTimeEntry reads a row selected with timeEntryColumns. Running timers
+// count their seconds up to now.
+func scanTimeEntry(row rowScanner, now time.Time) (*pb.TimeEntry, error) {
+	entry := &pb.TimeEntry{}
+	err := row.Scan(&entry.EntryId, &entry.TaskId, &entry.User, &entry.StartedAt, &entry.EndedAt, &entry.Seconds, &entry.Note, &entry.Manual)
+	if err != nil {
+		return nil, err
+	}
+	if entry.EndedAt == 0 {
+		entry.Seconds = max(now.Unix()-entry.StartedAt, 0)
+	}
+	return entry, nil
+}
+
+func getTimeEntry(q queryer, id int64, now time.Time) (*pb.TimeEntry, error) {
+	entry, err := scanTimeEntry(q.QueryRow("SELECT "+timeEntryColumns+" FROM time_entries WHERE entryId = ?", id), now)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "time entry %d not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading time entry %d: %v", id, err)
+	}
+	return entry, nil
+}
+
+// runningTimer returns the timer user is running, nil if there is none.
+func runningTimer(q queryer, user string, now time.Time) (*pb.TimeEntry, error) {
+	entry, err := scanTimeEntry(q.QueryRow("SELECT "+timeEntryColumns+" FROM time_entries WHERE user = ? AND endedAt = 0", user), now)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading the timer of %s: %v", user, err)
+	}
+	return entry, nil
+}
+
+// trackableTask loads a task time can be logged on, refusing the ones in the trash.
+func trackableTask(q queryer, id int64) (*pb.Task, error) {
+	task, err := getTask(q, id)
+	if err != nil {
+		return nil, err
+	}
+	if task.DeletedAt != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "task %d is in the trash", id)
+	}
+	return task, nil
+}
+
+// loggedTask loads a task time was logged on, nil if it was purged. Time
+// entries and focus sessions outlive their tasks so reports keep the time spent.
+func loggedTask(q queryer, id int64) (*pb.Task, error) {
+	task, err := getTask(q, id)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	return task, err
+}
+
+// addTrackedTime adds seconds, negative to remove them, to the total of a task.
+// The total is server state like overdueAt: changing it keeps the version of
+// the task and is not recorded in its history.
+func addTrackedTime(tx *txn, taskId, seconds int64) error {
+	if _, err := tx.Exec("UPDATE tasks SET trackedSeconds = MAX(trackedSeconds + ?, 0) WHERE taskId = ?", seconds, taskId); err != nil {
+		return status.Errorf(codes.Internal, "adding time to task %d: %v", taskId, err)
+	}
+	return nil
+}
+
+// insertTimer starts a timer for user on a task at the time of tx.
+func insertTimer(tx *txn, user string, taskId int64, note string) (*pb.TimeEntry, error) {
+	query := "INSERT INTO time_entries (taskId, user, startedAt, note) VALUES (?, ?, ?, ?)"
+	res, err := tx.Exec(query, taskId, user, tx.now.Unix(), strings.TrimSpace(note))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "starting the timer: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "starting the timer: %v", err)
+	}
+	return getTimeEntry(tx, id, tx.now)
+}
+
+// stopTimer ends a running timer at the time of tx and adds its time to its
+// task, ending the focus session it times. note replaces the note of the
+// entry if set.
+func stopTimer(tx *txn, entry *pb.TimeEntry, note string) (*pb.TimeEntry, error) {
+	if note = strings.TrimSpace(note); note == "" {
+		note = entry.Note
+	}
+	seconds := max(tx.now.Unix()-entry.StartedAt, 0)
+	query := "UPDATE time_entries SET endedAt = ?, seconds = ?, note = ? WHERE entryId = ?"
+	if _, err := tx.Exec(query, entry.StartedAt+seconds, seconds, note, entry.EntryId); err != nil {
+		return nil, status.Errorf(codes.Internal, "stopping time entry %d: %v", entry.EntryId, err)
+	}
+	if err := addTrackedTime(tx, entry.TaskId, seconds); err != nil {
+		return nil, err
+	}
+	if err := endFocusSession(tx, entry.EntryId, false); err != nil {
+		return nil, err
+	}
+	return getTimeEntry(tx, entry.EntryId, tx.now)
+}
+
+// StartTimer starts timing a task for the caller. Users time one task at a
+// time, so a timer running on another task is stopped first. Starting the
+// timer that already runs returns it unchanged.
+func (s *Server) StartTimer(ctx context.Context, in *pb.TimerRequest) (*pb.TimeEntryResponse, error) {
+	if in == nil || in.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TaskId is empty")
+	}
+	user := ActorFromContext(ctx)
+	res := &pb.TimeEntryResponse{}
+	err := s.inTx(func(tx *txn) error {
+		if _, err := trackableTask(tx, in.TaskId); err != nil {
+			return err
+		}
+		running, err := runningTimer(tx, user, tx.now)
+		if err != nil {
+			return err
+		}
+		if running != nil && running.TaskId == in.TaskId {
+			res.Entry = running
+		} else {
+			if running != nil {
+				if res.Stopped, err = stopTimer(tx, running, ""); err != nil {
+					return err
+				}
+			}
+			if res.Entry, err = insertTimer(tx, user, in.TaskId, in.Note); err != nil {
+				return err
+			}
+		}
+		res.Task, err = getTask(tx, in.TaskId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StopTimer stops the timer of the caller and adds its time to its task. A
+// taskId stops the timer only if it runs on that task.
+func (s *Server) StopTimer(ctx context.Context, in *pb.TimerRequest) (*pb.TimeEntryResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "Request is nil")
+	}
+	user := ActorFromContext(ctx)
+	res := &pb.TimeEntryResponse{}
+	err := s.inTx(func(tx *txn) error {
+		running, err := runningTimer(tx, user, tx.now)
+		if err != nil {
+			return err
+		}
+		if running == nil || (in.TaskId != 0 && running.TaskId != in.TaskId) {
+			if in.TaskId != 0 {
+				return status.Errorf(codes.FailedPrecondition, "%s has no timer running on task %d", user, in.TaskId)
+			}
+			return status.Errorf(codes.FailedPrecondition, "%s has no timer running", user)
+		}
+		if res.Entry, err = stopTimer(tx, running, in.Note); err != nil {
+			return err
+		}
+		res.Task, err = loggedTask(tx, running.TaskId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// normalizeTimeEntry checks a manual entry at now, filling in endedAt or
+// seconds from the other.
+func normalizeTimeEntry(entry *pb.TimeEntry, now time.Time) error {
+	violations := validators.Violations{}
+	entry.Note = strings.TrimSpace(entry.Note)
+	if entry.TaskId == 0 {
+		violations.Add("taskId", "set the task the time was spent on")
+	}
+	if entry.StartedAt <= 0 {
+		violations.Add("startedAt", "set when the work started")
+	}
+	switch {
+	case entry.Seconds < 0:
+		violations.Add("seconds", "seconds can't be negative")
+	case entry.EndedAt == 0 && entry.Seconds == 0:
+		violations.Add("seconds", "set when the work ended or how long it took")
+	case entry.EndedAt == 0:
+		entry.EndedAt = entry.StartedAt + entry.Seconds
+	case entry.Seconds != 0 && entry.Seconds != entry.EndedAt-entry.StartedAt:
+		violations.Add("seconds", "seconds don't match startedAt and endedAt")
+	}
+	if entry.StartedAt > 0 && entry.EndedAt != 0 {
+		entry.Seconds = entry.EndedAt - entry.StartedAt
+		switch {
+		case entry.Seconds <= 0:
+			violations.Add("endedAt", "the work ends before it starts")
+		case entry.EndedAt > now.Unix():
+			violations.Add("endedAt", "the work ends in the future")
+		case entry.Seconds > int64(maxTimeEntry/time.Second):
+			violations.Add("seconds", "an entry covers at most 24 hours")
+		}
+	}
+	return violations.Err()
+}
+
+// LogTime records time the caller spent on a task by hand and adds it to the
+// task. Entries may overlap the timers and other entries of the caller.
+func (s *Server) LogTime(ctx context.Context, in *pb.TimeEntryRequest) (*pb.TimeEntryResponse, error) {
+	if in == nil || in.Entry == nil {
+		return nil, status.Error(codes.InvalidArgument, "Entry is nil")
+	}
+	entry := &pb.TimeEntry{
+		TaskId: in.Entry.TaskId, StartedAt: in.Entry.StartedAt, EndedAt: in.Entry.EndedAt,
+		Seconds: in.Entry.Seconds, Note: in.Entry.Note,
+	}
+	if err := normalizeTimeEntry(entry, s.now()); err != nil {
+		return nil, err
+	}
+
+	res := &pb.TimeEntryResponse{}
+	err := s.inTx(func(tx *txn) error {
+		if _, err := trackableTask(tx, entry.TaskId); err != nil {
+			return err
+		}
+		query := "INSERT INTO time_entries (taskId, user, startedAt, endedAt, seconds, note, manual) VALUES (?, ?, ?, ?, ?, ?, 1)"
+		inserted, err := tx.Exec(query, entry.TaskId, ActorFromContext(ctx), entry.StartedAt, entry.EndedAt, entry.Seconds, entry.Note)
+		if err != nil {
+			return status.Errorf(codes.Internal, "logging time: %v", err)
+		}
+		id, err := inserted.LastInsertId()
+		if err != nil {
+			return status.Errorf(codes.Internal, "logging time: %v", err)
+		}
+		if err := addTrackedTime(tx, entry.TaskId, entry.Seconds); err != nil {
+			return err
+		}
+		if res.Entry, err = getTimeEntry(tx, id, tx.now); err != nil {
+			return err
+		}
+		res.Task, err = getTask(tx, entry.TaskId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteTimeEntry removes an entry, a running timer included, and takes its
+// time off its task. A focus session the timer ran is cut short. Only the
+// user who logged an entry can remove it.
+func (s *Server) DeleteTimeEntry(ctx context.Context, in *pb.TimeEntryRequest) (*pb.TimeEntryResponse, error) {
+	if in == nil || in.Entry == nil || in.Entry.EntryId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "EntryId is empty")
+	}
+	res := &pb.TimeEntryResponse{}
+	err := s.inTx(func(tx *txn) error {
+		entry, err := getTimeEntry(tx, in.Entry.EntryId, tx.now)
+		if err != nil {
+			return err
+		}
+		if user := ActorFromContext(ctx); entry.User != user {
+			return status.Errorf(codes.PermissionDenied, "time entry %d was logged by %s, not %s", entry.EntryId, entry.User, user)
+		}
+		if _, err := tx.Exec("DELETE FROM time_entries WHERE entryId = ?", entry.EntryId); err != nil {
+			return status.Errorf(codes.Internal, "deleting time entry %d: %v", entry.EntryId, err)
+		}
+		if entry.EndedAt != 0 {
+			if err := addTrackedTime(tx, entry.TaskId, -entry.Seconds); err != nil {
+				return err
+			}
+		} else if err := endFocusSession(tx, entry.EntryId, true); err != nil {
+			return err
+		}
+		res.Entry = entry
+		res.Task, err = loggedTask(tx, entry.TaskId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListTimeEntries returns the logged time, most recent first, optionally of
+// one task or user or only the running timers.
+func (s *Server) ListTimeEntries(ctx context.Context, in *pb.TimeEntriesRequest) (*pb.TimeEntriesResponse, error) {
+	var where []string
+	var args []any
+	if in.GetTaskId() != 0 {
+		where = append(where, "taskId = ?")
+		args = append(args, in.TaskId)
+	}
+	if user := strings.TrimSpace(in.GetUser()); user != "" {
+		where = append(where, "user = ?")
+		args = append(args, user)
+	}
+	if in.GetRunningOnly() {
+		where = append(where, "endedAt = 0")
+	}
+	query := "SELECT " + timeEntryColumns + " FROM time_entries"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	entries, err := queryTimeEntries(s.Db, s.now(), query+" ORDER BY startedAt DESC, entryId DESC", args...)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TimeEntriesResponse{Entries: entries}, nil
+}
+
+// queryTimeEntries runs a query selecting timeEntryColumns and collects the entries.
+func queryTimeEntries(q queryer, now time.Time, query string, args ...any) ([]*pb.TimeEntry, error) {
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing time entries: %v", err)
+	}
+	defer rows.Close()
+
+	var entries []*pb.TimeEntry
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows, now)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reading a time entry: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading time entries: %v", err)
+	}
+	return entries, nil
+}
+
+// parseDayRange reads the first and last day of a report, both YYYY-MM-DD in
+// the location of now. The range ends today and covers DefaultReportDays by default.
+func parseDayRange(from, until string, now time.Time) (time.Time, time.Time, error) {
+	loc := now.Location()
+	last := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	var err error
+	if until != "" {
+		if last, err = time.ParseInLocation(DeadlineDateLayout, until, loc); err != nil {
+			return time.Time{}, time.Time{}, validators.FieldError("until", "until must look like 2006-01-02")
+		}
+	}
+	first := last.AddDate(0, 0, 1-DefaultReportDays)
+	if from != "" {
+		if first, err = time.ParseInLocation(DeadlineDateLayout, from, loc); err != nil {
+			return time.Time{}, time.Time{}, validators.FieldError("from", "from must look like 2006-01-02")
+		}
+	}
+	if first.After(last) {
+		return time.Time{}, time.Time{}, validators.FieldError("from", "from is after until")
+	}
+	if first.AddDate(0, 0, maxReportDays).Before(last) {
+		return time.Time{}, time.Time{}, validators.FieldError("from", "a report covers at most 366 days")
+	}
+	return first, last, nil
+}
+
+// GetTimesheet adds up the time a user spent per day and task. Days are in
+// the time zone of the caller; entries spanning midnight are split between
+// the days and running timers count up to now.
+func (s *Server) GetTimesheet(ctx context.Context, in *pb.TimesheetRequest) (*pb.TimesheetResponse, error) {
+	loc, err := userLocation(ctx, s.Db)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().In(loc)
+	from, until, err := parseDayRange(in.GetFrom(), in.GetUntil(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	user := strings.TrimSpace(in.GetUser())
+	if user == "" {
+		user = ActorFromContext(ctx)
+	}
+	end := until.AddDate(0, 0, 1)
+	entries, err := queryTimeEntries(s.Db, now, "SELECT "+timeEntryColumns+" FROM time_entries WHERE user = ? AND startedAt < ? AND (endedAt = 0 OR endedAt > ?)",
+		user, end.Unix(), from.Unix())
+	if err != nil {
+		return nil, err
+	}
+	titles := map[int64]string{}
+	for _, entry := range entries {
+		task, err := loggedTask(s.Db, entry.TaskId)
+		if err != nil {
+			return nil, err
+		}
+		if task != nil {
+			titles[entry.TaskId] = task.Title
+		}
+	}
+
+	timesheet := &pb.TimesheetResponse{User: user, TimeZone: loc.String()}
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		dayStart, dayEnd := day.Unix(), day.AddDate(0, 0, 1).Unix()
+		point := &pb.TimesheetDay{Date: day.Format(DeadlineDateLayout)}
+		perTask := map[int64]int64{}
+		for _, entry := range entries {
+			overlap := min(entry.StartedAt+entry.Seconds, dayEnd) - max(entry.StartedAt, dayStart)
+			if overlap > 0 {
+				perTask[entry.TaskId] += overlap
+				point.Seconds += overlap
+			}
+		}
+		for id, seconds := range perTask {
+			point.Tasks = append(point.Tasks, &pb.TimesheetTask{TaskId: id, Title: titles[id], Seconds: seconds})
+		}
+		slices.SortFunc(point.Tasks, func(a, b *pb.TimesheetTask) int {
+			return cmp.Or(cmp.Compare(b.Seconds, a.Seconds), cmp.Compare(a.TaskId, b.TaskId))
+		})
+		timesheet.Days = append(timesheet.Days, point)
+		timesheet.TotalSeconds += point.Seconds
+	}
+	return timesheet, nil
+}
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+	validators "taskify/backend/validators"
+)
+
+func TestTimers(t *testing.T) {
+	ctx := context.Background()
+	testServer, fake := newFakeClockServer(t)
+	alice := WithActor(ctx, "alice")
+	create := func(title string) *pb.Task {
+		t.Helper()
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "Work", ExitCriteria: "Done", Deadline: fake.Now().Add(48 * time.Hour).Unix(),
+		}})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", title, err)
+		}
+		return res.Task
+	}
+	report, review := create("Write report"), create("Review PR")
+
+	if _, err := testServer.StartTimer(alice, &pb.TimerRequest{TaskId: report.TaskId, Note: "Draft"}); err != nil {
+		t.Fatalf("StartTimer: %v", err)
+	}
+	fake.Advance(20 * time.Minute)
+	running, err := testServer.ListTimeEntries(ctx, &pb.TimeEntriesRequest{RunningOnly: true})
+	if err != nil || len(running.Entries) != 1 || running.Entries[0].Seconds != 20*60 {
+		t.Fatalf("ListTimeEntries = %v, %v, want one timer running for 20 minutes", running, err)
+	}
+
+	// Starting another timer stops the first one
+	res, err := testServer.StartTimer(alice, &pb.TimerRequest{TaskId: review.TaskId})
+	if err != nil {
+		t.Fatalf("StartTimer: %v", err)
+	}
+	if res.Stopped.GetTaskId() != report.TaskId || res.Stopped.Seconds != 20*60 || res.Stopped.Note != "Draft" {
+		t.Errorf("Stopped = %v, want the report timer after 20 minutes", res.Stopped)
+	}
+	fake.Advance(5 * time.Minute)
+	if _, err := testServer.StopTimer(alice, &pb.TimerRequest{TaskId: report.TaskId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("StopTimer on the stopped task = %v, want FailedPrecondition", err)
+	}
+	if res, err = testServer.StopTimer(alice, &pb.TimerRequest{Note: "Approved"}); err != nil {
+		t.Fatalf("StopTimer: %v", err)
+	}
+	if res.Entry.Seconds != 5*60 || res.Entry.Note != "Approved" || res.Task.TrackedSeconds != 5*60 {
+		t.Errorf("StopTimer = %v, want 5 minutes logged on the review", res)
+	}
+
+	// Tracked time keeps the version and survives updates
+	report, _ = testServer.GetTask(ctx, report.TaskId)
+	if report.TrackedSeconds != 20*60 || report.Version != 1 {
+		t.Errorf("Report tracked %d seconds at version %d, want 1200 at version 1", report.TrackedSeconds, report.Version)
+	}
+	report.Description = "Quarterly"
+	updated, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: report})
+	if err != nil || updated.Task.TrackedSeconds != 20*60 {
+		t.Errorf("UpdateTask = %v, %v, want the tracked time kept", updated, err)
+	}
+
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: review}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if _, err := testServer.StartTimer(alice, &pb.TimerRequest{TaskId: review.TaskId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("StartTimer on a trashed task = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestLogTime(t *testing.T) {
+	ctx := context.Background()
+	testServer, fake := newFakeClockServer(t)
+	alice, bob := WithActor(ctx, "alice"), WithActor(ctx, "bob")
+	task, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+		Title: "Plan sprint", Description: "Plan", ExitCriteria: "Planned", Deadline: fake.Now().Add(time.Hour).Unix(), EstimateMinutes: 60,
+	}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	id := task.Task.TaskId
+
+	start := fake.Now().Add(-3 * time.Hour).Unix()
+	res, err := testServer.LogTime(alice, &pb.TimeEntryRequest{Entry: &pb.TimeEntry{TaskId: id, StartedAt: start, Seconds: 45 * 60}})
+	if err != nil {
+		t.Fatalf("LogTime: %v", err)
+	}
+	if entry := res.Entry; !entry.Manual || entry.User != "alice" || entry.EndedAt != start+45*60 || res.Task.TrackedSeconds != 45*60 {
+		t.Errorf("LogTime = %v, want 45 manual minutes of alice", res)
+	}
+	if _, err := testServer.LogTime(alice, &pb.TimeEntryRequest{Entry: &pb.TimeEntry{TaskId: id, StartedAt: start, EndedAt: start + 60*60}}); err != nil {
+		t.Fatalf("LogTime: %v", err)
+	}
+
+	if _, err := testServer.DeleteTimeEntry(bob, &pb.TimeEntryRequest{Entry: res.Entry}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteTimeEntry by bob = %v, want PermissionDenied", err)
+	}
+	if res, err = testServer.DeleteTimeEntry(alice, &pb.TimeEntryRequest{Entry: res.Entry}); err != nil || res.Task.TrackedSeconds != 60*60 {
+		t.Errorf("DeleteTimeEntry = %v, %v, want an hour left", res, err)
+	}
+
+	// An hour spent on an hour long estimate, then one more
+	done, _ := testServer.GetTask(ctx, id)
+	done.Complete = true
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: done}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	stats, err := testServer.GetStats(ctx, &pb.StatsRequest{})
+	if err != nil {
+		t.Fatalf("GetStats: %v", err)
+	}
+	if estimates := stats.Estimates; estimates.Tasks != 1 || estimates.EstimatedSeconds != 3600 || estimates.TrackedToEstimated != 1 || estimates.Underestimated+estimates.Overestimated != 0 {
+		t.Errorf("Estimates = %v, want one task done as estimated", estimates)
+	}
+	if _, err := testServer.LogTime(alice, &pb.TimeEntryRequest{Entry: &pb.TimeEntry{TaskId: id, StartedAt: start, Seconds: 30 * 60}}); err != nil {
+		t.Fatalf("LogTime: %v", err)
+	}
+	if stats, err = testServer.GetStats(ctx, &pb.StatsRequest{}); err != nil || stats.Estimates.Underestimated != 1 || stats.Estimates.TrackedToEstimated != 1.5 {
+		t.Errorf("GetStats = %v, %v, want the task underestimated by half", stats.GetEstimates(), err)
+	}
+}
+
+func TestLogTime_Invalid(t *testing.T) {
+	testServer, fake := newFakeClockServer(t)
+	now := fake.Now().Unix()
+	for _, tc := range []struct {
+		entry *pb.TimeEntry
+		want  map[string]string
+	}{
+		{&pb.TimeEntry{}, map[string]string{
+			"taskId":    "set the task the time was spent on",
+			"startedAt": "set when the work started",
+			"seconds":   "set when the work ended or how long it took",
+		}},
+		{&pb.TimeEntry{TaskId: 1, StartedAt: now - 60, EndedAt: now - 120}, map[string]string{"endedAt": "the work ends before it starts"}},
+		{&pb.TimeEntry{TaskId: 1, StartedAt: now - 60, Seconds: 120}, map[string]string{"endedAt": "the work ends in the future"}},
+		{&pb.TimeEntry{TaskId: 1, StartedAt: now - 600, EndedAt: now, Seconds: 60}, map[string]string{"seconds": "seconds don't match startedAt and endedAt"}},
+		{&pb.TimeEntry{TaskId: 1, StartedAt: now - 2*24*60*60, Seconds: 25 * 60 * 60}, map[string]string{"seconds": "an entry covers at most 24 hours"}},
+	} {
+		_, err := testServer.LogTime(context.Background(), &pb.TimeEntryRequest{Entry: tc.entry})
+		if diff := cmp.Diff(tc.want, validators.FieldViolations(err)); diff != "" {
+			t.Errorf("LogTime(%v) (-want,+got):%v", tc.entry, diff)
+		}
+	}
+}
+
+func TestGetTimesheet(t *testing.T) {
+	ctx := context.Background()
+	testServer, fake := newFakeClockServer(t)
+	alice := WithActor(ctx, "alice")
+	var ids []int64
+	for _, title := range []string{"Migrate database", "Answer email"} {
+		res, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: &pb.Task{
+			Title: title, Description: "Work", ExitCriteria: "Done", Deadline: fake.Now().Add(48 * time.Hour).Unix(),
+		}})
+		if err != nil {
+			t.Fatalf("CreateTask(%s): %v", title, err)
+		}
+		ids = append(ids, res.Task.TaskId)
+	}
+
+	// Two hours across midnight, then a running timer
+	midnight := time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := testServer.LogTime(alice, &pb.TimeEntryRequest{Entry: &pb.TimeEntry{
+		TaskId: ids[0], StartedAt: midnight.Add(-time.Hour).Unix(), Seconds: 2 * 60 * 60,
+	}}); err != nil {
+		t.Fatalf("LogTime: %v", err)
+	}
+	if _, err := testServer.StartTimer(alice, &pb.TimerRequest{TaskId: ids[1]}); err != nil {
+		t.Fatalf("StartTimer: %v", err)
+	}
+	fake.Advance(90 * time.Minute)
+
+	timesheet, err := testServer.GetTimesheet(alice, &pb.TimesheetRequest{From: "2030-02-28"})
+	if err != nil {
+		t.Fatalf("GetTimesheet: %v", err)
+	}
+	var got [][]any
+	for _, day := range timesheet.Days {
+		for _, task := range day.Tasks {
+			got = append(got, []any{day.Date, day.Seconds, task.Title, task.Seconds})
+		}
+	}
+	want := [][]any{
+		{"2030-02-28", int64(3600), "Migrate database", int64(3600)},
+		{"2030-03-01", int64(9000), "Answer email", int64(5400)},
+		{"2030-03-01", int64(9000), "Migrate database", int64(3600)},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Timesheet (-want,+got):%v", diff)
+	}
+	if timesheet.User != "alice" || len(timesheet.Days) != 2 || timesheet.TotalSeconds != 12600 {
+		t.Errorf("Timesheet of %s has %d days and %d seconds, want alice, 2 days and 12600 seconds", timesheet.User, len(timesheet.Days), timesheet.TotalSeconds)
+	}
+
+	if timesheet, err = testServer.GetTimesheet(ctx, &pb.TimesheetRequest{User: "bob"}); err != nil || len(timesheet.Days) != DefaultReportDays || timesheet.TotalSeconds != 0 {
+		t.Errorf("GetTimesheet(bob) = %v, %v, want a week without time", timesheet, err)
+	}
+}
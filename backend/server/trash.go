@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+)
+
+// DefaultTrashRetention is how long a deleted task stays in the trash before it is purged.
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// trashRetention returns the configured retention period.
+func (s *Server) trashRetention() time.Duration {
+	if s.TrashRetention <= 0 {
+		return DefaultTrashRetention
+	}
+	return s.TrashRetention
+}
+
+// trashedTask loads a task and makes sure it is in the trash. Callers check
+// inside the transaction that changes the task, so a concurrent restore or
+// purge can't slip in between.
+func trashedTask(q queryer, in *pb.TaskRequest) (*pb.Task, error) {
+	if in == nil || in.Task == nil {
+		return nil, status.Error(codes.InvalidArgument, "Task is nil")
+	}
+	if in.Task.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "TaskId is empty")
+	}
+	task, err := getTask(q, in.Task.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if task.DeletedAt == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "task %d is not in the trash", task.TaskId)
+	}
+	return task, nil
+}
+
+// ListTrash returns the tasks in the trash, most recently deleted first
+func (s *Server) ListTrash(ctx context.Context, in *pb.TaskRequest) (*pb.ListTaskResponse, error) {
+	tasks, err := queryTasks(s.Db, "SELECT "+taskColumns+" FROM tasks WHERE deletedAt > 0 ORDER BY deletedAt DESC, taskId ASC")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListTaskResponse{Tasks: tasks}, nil
+}
+
+// RestoreTask moves a task out of the trash
+func (s *Server) RestoreTask(ctx context.Context, in *pb.TaskRequest) (*pb.TaskResponse, error) {
+	var task *pb.Task
+	err := s.inTx(func(tx *txn) error {
+		before, err := trashedTask(tx, in)
+		if err != nil {
+			return err
+		}
+		query := "UPDATE tasks SET deletedAt = 0, version = version + 1, updateTime = ? WHERE taskId = ? AND deletedAt > 0"
+		res, err := tx.Exec(query, tx.now.Unix(), before.TaskId)
+		if err != nil {
+			return storageError(err)
+		}
+		if restored, err := res.RowsAffected(); err != nil || restored == 0 {
+			return status.Errorf(codes.FailedPrecondition, "task %d is not in the trash", before.TaskId)
+		}
+		task, err = getTask(tx, before.TaskId)
+		if err != nil {
+			return err
+		}
+		return recordHistory(ctx, tx, task.TaskId, ActionRestore, before, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TaskResponse{Task: task}, nil
+}
+
+// PurgeTask permanently deletes a task that is in the trash
+func (s *Server) PurgeTask(ctx context.Context, in *pb.TaskRequest) (*pb.DeleteTaskResponse, error) {
+	var purged bool
+	err := s.inTx(func(tx *txn) error {
+		task, err := trashedTask(tx, in)
+		if err != nil {
+			return err
+		}
+		if purged, err = purgeTask(ctx, tx, task); err != nil {
+			return storageError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteTaskResponse{Success: purged}, nil
+}
+
+// purgeTask deletes a trashed task row and records it in the history.
+func purgeTask(ctx context.Context, tx *txn, task *pb.Task) (bool, error) {
+	res, err := tx.Exec("DELETE FROM tasks WHERE taskId = ? AND deletedAt > 0", task.TaskId)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil || rowsAffected == 0 {
+		return false, err
+	}
+	return true, recordHistory(ctx, tx, task.TaskId, ActionPurge, task, nil)
+}
+
+// PurgeExpiredTrash permanently deletes the tasks that have been in the trash
+// for longer than the retention period and returns how many were removed.
+func (s *Server) PurgeExpiredTrash(ctx context.Context) (int64, error) {
+	cutoff := s.now().Add(-s.trashRetention()).Unix()
+
+	var purged int64
+	err := s.inTx(func(tx *txn) error {
+		expired, err := queryTasks(tx, "SELECT "+taskColumns+" FROM tasks WHERE deletedAt > 0 AND deletedAt <= ?", cutoff)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range expired {
+			ok, err := purgeTask(ctx, tx, task)
+			if err != nil {
+				return err
+			}
+			if ok {
+				purged++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("purging expired trash: %w", err)
+	}
+	return purged, nil
+}
+
+// StartTrashPurger purges expired trash every interval until ctx is cancelled.
+func (s *Server) StartTrashPurger(ctx context.Context, interval time.Duration) {
+	ctx = WithActor(ctx, SystemActor)
+	ticker := s.timeSource().NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C():
+				purged, err := s.PurgeExpiredTrash(ctx)
+				if err != nil {
+					log.Printf("Trash purge failed: %v", err)
+				} else if purged > 0 {
+					log.Printf("Purged %d expired tasks from the trash", purged)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createTestTask(t *testing.T, s *Server, title string) *pb.Task {
+	t.Helper()
+	res, err := s.CreateTask(context.Background(), &pb.TaskRequest{
+		Task: &pb.Task{
+			Title:        title,
+			Description:  "This is the task",
+			Deadline:     time.Now().Add(1 * time.Hour).Unix(),
+			ExitCriteria: "Finish it",
+		},
+	})
+	if err != nil {
+		t.Fatalf("The task could not be created: %v", err)
+	}
+	return res.Task
+}
+
+func TestDeleteTask_MovesToTrash(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	task := createTestTask(t, &testServer, "Test Task")
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("DeleteTask(%d): %v", task.TaskId, err)
+	}
+
+	listed, err := testServer.ListTasks(ctx, &pb.TaskRequest{Task: &pb.Task{}})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(listed.Tasks) != 0 {
+		t.Errorf("ListTasks returned %d tasks, trashed tasks should be excluded", len(listed.Tasks))
+	}
+
+	listed, err = testServer.ListTasks(ctx, &pb.TaskRequest{Task: &pb.Task{}, IncludeDeleted: true})
+	if err != nil {
+		t.Fatalf("ListTasks(includeDeleted): %v", err)
+	}
+	if len(listed.Tasks) != 1 || listed.Tasks[0].DeletedAt == 0 {
+		t.Errorf("ListTasks(includeDeleted) = %v, want the trashed task", listed.Tasks)
+	}
+
+	trash, err := testServer.ListTrash(ctx, &pb.TaskRequest{})
+	if err != nil {
+		t.Fatalf("ListTrash: %v", err)
+	}
+	if len(trash.Tasks) != 1 || trash.Tasks[0].TaskId != task.TaskId {
+		t.Errorf("ListTrash = %v, want task %d", trash.Tasks, task.TaskId)
+	}
+}
+
+func TestRestoreTask(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	task := createTestTask(t, &testServer, "Test Task")
+	if _, err := testServer.RestoreTask(ctx, &pb.TaskRequest{Task: task}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("RestoreTask on an active task: got %v, want FailedPrecondition", err)
+	}
+
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("DeleteTask(%d): %v", task.TaskId, err)
+	}
+	res, err := testServer.RestoreTask(ctx, &pb.TaskRequest{Task: task})
+	if err != nil {
+		t.Fatalf("RestoreTask(%d): %v", task.TaskId, err)
+	}
+	if res.Task.DeletedAt != 0 {
+		t.Errorf("Restored task still has deletedAt %d", res.Task.DeletedAt)
+	}
+
+	listed, err := testServer.ListTasks(ctx, &pb.TaskRequest{Task: &pb.Task{}})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(listed.Tasks) != 1 {
+		t.Errorf("ListTasks returned %d tasks after restoring, want 1", len(listed.Tasks))
+	}
+}
+
+func TestRestoreTask_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	task := createTestTask(t, &testServer, "Test Task")
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("DeleteTask(%d): %v", task.TaskId, err)
+	}
+	errs := make(chan error, 2)
+	for range 2 {
+		go func() {
+			_, err := testServer.RestoreTask(ctx, &pb.TaskRequest{Task: task})
+			errs <- err
+		}()
+	}
+	var restored int
+	for range 2 {
+		switch err := <-errs; status.Code(err) {
+		case codes.OK:
+			restored++
+		case codes.FailedPrecondition:
+		default:
+			t.Errorf("RestoreTask: got %v, want OK or FailedPrecondition", err)
+		}
+	}
+	if restored != 1 {
+		t.Errorf("%d restores succeeded, want 1", restored)
+	}
+	history, err := testServer.GetTaskHistory(ctx, &pb.TaskRequest{Task: task})
+	if err != nil {
+		t.Fatalf("GetTaskHistory: %v", err)
+	}
+	if last := history.Changes[len(history.Changes)-1]; len(history.Changes) != 3 || last.Action != ActionRestore {
+		t.Errorf("History = %v, want one restore after the create and delete", history.Changes)
+	}
+
+	// An open task with the same texts now takes the place of the trashed one
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: &pb.Task{TaskId: task.TaskId}}); err != nil {
+		t.Fatalf("DeleteTask(%d): %v", task.TaskId, err)
+	}
+	again := &pb.Task{Title: task.Title, Description: task.Description, Deadline: task.Deadline, ExitCriteria: task.ExitCriteria}
+	if _, err := testServer.CreateTask(ctx, &pb.TaskRequest{Task: again}); err != nil {
+		t.Fatalf("CreateTask of a trashed duplicate: %v", err)
+	}
+	if _, err := testServer.RestoreTask(ctx, &pb.TaskRequest{Task: task}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("RestoreTask of a duplicate: got %v, want AlreadyExists", err)
+	}
+}
+
+func TestPurgeTask(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	task := createTestTask(t, &testServer, "Test Task")
+	if _, err := testServer.PurgeTask(ctx, &pb.TaskRequest{Task: task}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("PurgeTask on an active task: got %v, want FailedPrecondition", err)
+	}
+
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("DeleteTask(%d): %v", task.TaskId, err)
+	}
+	res, err := testServer.PurgeTask(ctx, &pb.TaskRequest{Task: task})
+	if err != nil {
+		t.Fatalf("PurgeTask(%d): %v", task.TaskId, err)
+	}
+	if !res.Success {
+		t.Errorf("PurgeTask(%d) did not remove the task", task.TaskId)
+	}
+	if _, err := testServer.GetTask(ctx, task.TaskId); status.Code(err) != codes.NotFound {
+		t.Errorf("GetTask after purge: got %v, want NotFound", err)
+	}
+}
+
+func TestPurgeExpiredTrash(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Now())
+	testServer := Server{Db: initializeTestingDatabase(t), TrashRetention: time.Hour, Clock: fake}
+
+	expired := createTestTask(t, &testServer, "Expired Task")
+	recent := createTestTask(t, &testServer, "Recent Task")
+	createTestTask(t, &testServer, "Active Task")
+
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: expired}); err != nil {
+		t.Fatalf("DeleteTask(%d): %v", expired.TaskId, err)
+	}
+	fake.Advance(time.Hour)
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: recent}); err != nil {
+		t.Fatalf("DeleteTask(%d): %v", recent.TaskId, err)
+	}
+
+	purged, err := testServer.PurgeExpiredTrash(ctx)
+	if err != nil {
+		t.Fatalf("PurgeExpiredTrash: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("PurgeExpiredTrash purged %d tasks, want 1", purged)
+	}
+	if _, err := testServer.GetTask(ctx, expired.TaskId); status.Code(err) != codes.NotFound {
+		t.Errorf("Expired task is still stored: %v", err)
+	}
+	if _, err := testServer.GetTask(ctx, recent.TaskId); err != nil {
+		t.Errorf("Recently deleted task was purged: %v", err)
+	}
+}
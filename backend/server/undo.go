@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "taskify/backend/proto"
+)
+
+// DefaultUndoWindow is how long after a change it can still be undone.
+const DefaultUndoWindow = 15 * time.Minute
+
+// undoWindow returns the configured undo window.
+func (s *Server) undoWindow() time.Duration {
+	if s.UndoWindow <= 0 {
+		return DefaultUndoWindow
+	}
+	return s.UndoWindow
+}
+
+// UndoLastChange reverts the caller's most recent create, update, delete,
+// restore or redo, optionally limited to the task in the request. It fails if
+// the change is older than the undo window or the task changed since.
+func (s *Server) UndoLastChange(ctx context.Context, in *pb.TaskRequest) (*pb.UndoResponse, error) {
+	query := "SELECT " + historyColumns + ` FROM task_history h
+		WHERE actor = ? AND action IN (?, ?, ?, ?, ?) AND (? = 0 OR taskId = ?)
+		AND NOT EXISTS (SELECT 1 FROM task_history u WHERE u.revertsId = h.historyId AND u.action = ?)
+		ORDER BY historyId DESC LIMIT 1`
+	taskId := in.GetTask().GetTaskId()
+	return s.revertLastChange(ctx, ActionUndo, query,
+		ActorFromContext(ctx), ActionCreate, ActionUpdate, ActionDelete, ActionRestore, ActionRedo, taskId, taskId, ActionUndo)
+}
+
+// RedoLastChange reapplies the caller's most recently undone change,
+// optionally limited to the task in the request.
+func (s *Server) RedoLastChange(ctx context.Context, in *pb.TaskRequest) (*pb.UndoResponse, error) {
+	query := "SELECT " + historyColumns + ` FROM task_history h
+		WHERE actor = ? AND action = ? AND (? = 0 OR taskId = ?)
+		AND NOT EXISTS (SELECT 1 FROM task_history r WHERE r.revertsId = h.historyId AND r.action = ?)
+		ORDER BY historyId DESC LIMIT 1`
+	taskId := in.GetTask().GetTaskId()
+	return s.revertLastChange(ctx, ActionRedo, query,
+		ActorFromContext(ctx), ActionUndo, taskId, taskId, ActionRedo)
+}
+
+// revertLastChange finds the history entry selected by query and puts the
+// task back to the state it had before that entry, recording it as action.
+func (s *Server) revertLastChange(ctx context.Context, action string, query string, args ...any) (*pb.UndoResponse, error) {
+	var task *pb.Task
+	var reverted *historyEntry
+	err := s.inTx(func(tx *txn) error {
+		var err error
+		reverted, err = scanHistoryEntry(tx.QueryRow(query, args...))
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "nothing to %s", action)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "finding the change to %s: %v", action, err)
+		}
+
+		if changedAt := time.Unix(reverted.changedAt, 0); tx.now.Sub(changedAt) > s.undoWindow() {
+			return status.Errorf(codes.FailedPrecondition, "the last change to task %d was made at %s, it can only be reverted within %s",
+				reverted.taskId, changedAt.Format(time.RFC3339), s.undoWindow())
+		}
+
+		current, err := checkNoChangeSince(tx, reverted)
+		if err != nil {
+			return err
+		}
+
+		if reverted.before != nil {
+			task = proto.Clone(reverted.before).(*pb.Task)
+		} else {
+			// Undoing a creation moves the task to the trash rather than losing it
+			task = proto.Clone(current).(*pb.Task)
+			task.DeletedAt = tx.now.Unix()
+		}
+		if err := writeTask(tx, task); err != nil {
+			return err
+		}
+		task, err = getTask(tx, task.TaskId)
+		if err != nil {
+			return err
+		}
+		return recordRevert(ctx, tx, task.TaskId, action, reverted.historyId, current, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndoResponse{Task: task, Change: reverted.toProto()}, nil
+}
+
+// serverStateFields are the task fields the server keeps up to date without
+// recording a change, so they are not part of what a revert checks or restores.
+var serverStateFields = []string{"OverdueAt", "Overdue", "TrackedSeconds", "FocusSessions"}
+
+// checkNoChangeSince returns the current state of the task, failing with
+// Aborted if another user changed it after the entry about to be reverted, or
+// if it no longer matches the entry. The caller's own later changes are fine
+// as long as they were undone, which is how successive undos walk back.
+func checkNoChangeSince(tx *txn, entry *historyEntry) (*pb.Task, error) {
+	var otherActor string
+	err := tx.QueryRow("SELECT actor FROM task_history WHERE taskId = ? AND historyId > ? AND actor != ? ORDER BY historyId DESC LIMIT 1",
+		entry.taskId, entry.historyId, entry.actor).Scan(&otherActor)
+	if err == nil {
+		return nil, status.Errorf(codes.Aborted, "task %d was changed by %s since, the change can no longer be reverted", entry.taskId, otherActor)
+	}
+	if err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "checking later changes: %v", err)
+	}
+
+	current, err := getTask(tx, entry.taskId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "task %d was purged", entry.taskId)
+		}
+		return nil, err
+	}
+	// Undo and redo bump the version, so successive reverts only compare the content
+	ignored := append([]string{"Version", "UpdateTime", "DeadlineTime"}, serverStateFields...)
+	if diff := cmp.Diff(entry.after, current, cmpopts.IgnoreFields(pb.Task{}, ignored...), cmpopts.IgnoreUnexported(pb.Task{})); diff != "" {
+		return nil, status.Error(codes.Aborted, fmt.Sprintf("task %d has changed since, the change can no longer be reverted", entry.taskId))
+	}
+	return current, nil
+}
@@ -0,0 +1,222 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUndoLastChange_Update(t *testing.T) {
+	testServer := Server{Db: initializeTestingDatabase(t)}
+	alice := WithActor(context.Background(), "alice")
+
+	task := createTestTask(t, &testServer, "Test Task")
+	original := task.Title
+	task.Title = "Renamed Task"
+	if _, err := testServer.UpdateTask(alice, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	res, err := testServer.UndoLastChange(alice, &pb.TaskRequest{})
+	if err != nil {
+		t.Fatalf("UndoLastChange: %v", err)
+	}
+	if res.Task.Title != original {
+		t.Errorf("UndoLastChange left title %q, want %q", res.Task.Title, original)
+	}
+	if res.Change.Action != ActionUpdate {
+		t.Errorf("UndoLastChange reverted a %q, want the update", res.Change.Action)
+	}
+
+	res, err = testServer.RedoLastChange(alice, &pb.TaskRequest{})
+	if err != nil {
+		t.Fatalf("RedoLastChange: %v", err)
+	}
+	if res.Task.Title != "Renamed Task" {
+		t.Errorf("RedoLastChange left title %q, want %q", res.Task.Title, "Renamed Task")
+	}
+}
+
+func TestUndoLastChange_Create_And_Delete(t *testing.T) {
+	ctx := context.Background()
+	testServer := Server{Db: initializeTestingDatabase(t)}
+
+	task := createTestTask(t, &testServer, "Test Task")
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	res, err := testServer.UndoLastChange(ctx, &pb.TaskRequest{})
+	if err != nil {
+		t.Fatalf("UndoLastChange of the delete: %v", err)
+	}
+	if res.Task.DeletedAt != 0 {
+		t.Errorf("Undoing the delete should restore the task, deletedAt is %d", res.Task.DeletedAt)
+	}
+
+	res, err = testServer.UndoLastChange(ctx, &pb.TaskRequest{})
+	if err != nil {
+		t.Fatalf("UndoLastChange of the create: %v", err)
+	}
+	if res.Task.DeletedAt == 0 {
+		t.Error("Undoing the create should move the task to the trash")
+	}
+
+	if _, err := testServer.UndoLastChange(ctx, &pb.TaskRequest{}); status.Code(err) != codes.NotFound {
+		t.Errorf("UndoLastChange with nothing left: got %v, want NotFound", err)
+	}
+}
+
+func TestUndoLastChange_Conflict(t *testing.T) {
+	testServer := Server{Db: initializeTestingDatabase(t)}
+	alice := WithActor(context.Background(), "alice")
+	bob := WithActor(context.Background(), "bob")
+
+	task := createTestTask(t, &testServer, "Test Task")
+	task.Title = "Alice's Title"
+	res, err := testServer.UpdateTask(alice, &pb.TaskRequest{Task: task})
+	if err != nil {
+		t.Fatalf("UpdateTask(alice): %v", err)
+	}
+	task = res.Task
+	task.Description = "Bob's description"
+	if _, err := testServer.UpdateTask(bob, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("UpdateTask(bob): %v", err)
+	}
+
+	if _, err := testServer.UndoLastChange(alice, &pb.TaskRequest{}); status.Code(err) != codes.Aborted {
+		t.Errorf("UndoLastChange after another user's change: got %v, want Aborted", err)
+	}
+}
+
+func TestUndoLastChange_Duplicate(t *testing.T) {
+	testServer := Server{Db: initializeTestingDatabase(t)}
+	alice := WithActor(context.Background(), "alice")
+	bob := WithActor(context.Background(), "bob")
+
+	task := createTestTask(t, &testServer, "Test Task")
+	if _, err := testServer.DeleteTask(alice, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("DeleteTask(alice): %v", err)
+	}
+	again := &pb.Task{Title: task.Title, Description: task.Description, Deadline: task.Deadline, ExitCriteria: task.ExitCriteria}
+	if _, err := testServer.CreateTask(bob, &pb.TaskRequest{Task: again}); err != nil {
+		t.Fatalf("CreateTask(bob): %v", err)
+	}
+
+	// Restoring the trashed task would make two open tasks the same
+	if _, err := testServer.UndoLastChange(alice, &pb.TaskRequest{}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("UndoLastChange of the delete: got %v, want AlreadyExists", err)
+	}
+}
+
+func TestUndoLastChange_Window(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	testServer := Server{Db: initializeTestingDatabase(t), UndoWindow: time.Minute, Clock: fake}
+
+	createTestTask(t, &testServer, "Test Task")
+	fake.Advance(time.Minute + time.Second)
+
+	if _, err := testServer.UndoLastChange(context.Background(), &pb.TaskRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UndoLastChange outside the window: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestUndoLastChange_Overdue(t *testing.T) {
+	testServer, fake := newFakeClockServer(t)
+	alice := WithActor(context.Background(), "alice")
+	res, err := testServer.CreateTask(alice, &pb.TaskRequest{Task: &pb.Task{
+		Title: "Late", Description: "Due", ExitCriteria: "Done", Deadline: fake.Now().Add(5 * time.Minute).Unix(),
+	}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	task := res.Task
+	task.Title = "Late report"
+	if _, err := testServer.UpdateTask(alice, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	// The overdue flag is server state, it does not count as a change since
+	fake.Advance(10 * time.Minute)
+	if marked, err := testServer.MarkOverdueTasks(context.Background()); err != nil || marked != 1 {
+		t.Fatalf("MarkOverdueTasks = %d, %v, want 1", marked, err)
+	}
+	undone, err := testServer.UndoLastChange(alice, &pb.TaskRequest{})
+	if err != nil {
+		t.Fatalf("UndoLastChange: %v", err)
+	}
+	if undone.Task.Title != "Late" || !undone.Task.Overdue {
+		t.Errorf("UndoLastChange = %v, want the title reverted and the task still overdue", undone.Task)
+	}
+	if _, err := testServer.RedoLastChange(alice, &pb.TaskRequest{}); err != nil {
+		t.Errorf("RedoLastChange: %v", err)
+	}
+}
+
+func TestUndoLastChange_LoggedTime(t *testing.T) {
+	testServer := Server{Db: initializeTestingDatabase(t)}
+	alice := WithActor(context.Background(), "alice")
+
+	task := createTestTask(t, &testServer, "Test Task")
+	task.Title = "Renamed Task"
+	if _, err := testServer.UpdateTask(alice, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	// Logged time is server state, it does not count as a change since
+	entry := &pb.TimeEntry{TaskId: task.TaskId, StartedAt: time.Now().Add(-time.Hour).Unix(), Seconds: 30 * 60}
+	if _, err := testServer.LogTime(alice, &pb.TimeEntryRequest{Entry: entry}); err != nil {
+		t.Fatalf("LogTime: %v", err)
+	}
+
+	res, err := testServer.UndoLastChange(alice, &pb.TaskRequest{})
+	if err != nil {
+		t.Fatalf("UndoLastChange: %v", err)
+	}
+	if res.Task.Title != "Test Task" || res.Task.TrackedSeconds != 30*60 {
+		t.Errorf("UndoLastChange = %v, want the title reverted and the time kept", res.Task)
+	}
+	if _, err := testServer.RedoLastChange(alice, &pb.TaskRequest{}); err != nil {
+		t.Errorf("RedoLastChange: %v", err)
+	}
+}
+
+func TestUndoLastChange_FocusSession(t *testing.T) {
+	testServer, fake := newFakeClockServer(t)
+	alice := WithActor(context.Background(), "alice")
+	res, err := testServer.CreateTask(alice, &pb.TaskRequest{Task: &pb.Task{
+		Title: "Write chapter", Description: "Work", ExitCriteria: "Done", Deadline: fake.Now().Add(48 * time.Hour).Unix(),
+	}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	task := res.Task
+	task.Title = "Write intro"
+	if _, err := testServer.UpdateTask(alice, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	// Finished sessions are server state, they do not count as a change since
+	if _, err := testServer.StartFocusSession(alice, &pb.FocusRequest{TaskId: task.TaskId, Minutes: 5}); err != nil {
+		t.Fatalf("StartFocusSession: %v", err)
+	}
+	fake.Advance(5 * time.Minute)
+	if _, err := testServer.StopFocusSession(alice, &pb.FocusRequest{}); err != nil {
+		t.Fatalf("StopFocusSession: %v", err)
+	}
+	undone, err := testServer.UndoLastChange(alice, &pb.TaskRequest{})
+	if err != nil {
+		t.Fatalf("UndoLastChange: %v", err)
+	}
+	if undone.Task.Title != "Write chapter" || undone.Task.FocusSessions != 1 {
+		t.Errorf("UndoLastChange = %v, want the title reverted and the session kept", undone.Task)
+	}
+	if _, err := testServer.RedoLastChange(alice, &pb.TaskRequest{}); err != nil {
+		t.Errorf("RedoLastChange: %v", err)
+	}
+}
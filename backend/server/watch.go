@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "taskify/backend/proto"
+)
+
+// WatchTasks streams the task changes matching the filter. With a resume token
+// it first replays the changes recorded after the token, so a client that
+// reconnects with the token of the last event it saw does not miss any.
+func (s *Server) WatchTasks(in *pb.WatchTasksRequest, stream grpc.ServerStreamingServer[pb.TaskEvent]) error {
+	return s.Watch(stream.Context(), in, stream.Send)
+}
+
+// Watch calls send with every task change matching the request until ctx is
+// done or send fails. It backs WatchTasks and the HTML live updates.
+func (s *Server) Watch(ctx context.Context, in *pb.WatchTasksRequest, send func(*pb.TaskEvent) error) error {
+	// Subscribe before replaying so nothing committed in between is lost
+	sub := s.bus().Subscribe()
+	defer sub.Close()
+
+	var lastReplayed int64
+	if in.GetResumeToken() != "" {
+		after, err := parseResumeToken(in.ResumeToken)
+		if err != nil {
+			return err
+		}
+		lastReplayed, err = s.replayEvents(ctx, after, func(event *pb.TaskEvent) error {
+			if !matchesFilter(in.Filter, event.Task) {
+				return nil
+			}
+			return send(event)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				if sub.Dropped() {
+					return status.Error(codes.ResourceExhausted, "the client fell too far behind, reconnect with the last resume token")
+				}
+				return nil
+			}
+			if event.Sequence <= lastReplayed || !matchesFilter(in.Filter, event.Task) {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// replayEvents sends the recorded changes after sequence after, oldest first,
+// and returns the sequence of the last one.
+func (s *Server) replayEvents(ctx context.Context, after int64, send func(*pb.TaskEvent) error) (int64, error) {
+	rows, err := s.Db.QueryContext(ctx, "SELECT "+historyColumns+" FROM task_history WHERE historyId > ? ORDER BY historyId ASC", after)
+	if err != nil {
+		return 0, status.Error(codes.Internal, fmt.Sprintf("Failed to query history: %v", err))
+	}
+	defer rows.Close()
+
+	last := after
+	for rows.Next() {
+		entry, err := scanHistoryEntry(rows)
+		if err != nil {
+			return 0, status.Error(codes.Internal, fmt.Sprintf("Failed to scan row: %v", err))
+		}
+		if err := send(entry.toEvent()); err != nil {
+			return 0, err
+		}
+		last = entry.historyId
+	}
+	if err := rows.Err(); err != nil {
+		return 0, status.Error(codes.Internal, fmt.Sprintf("Error encountered during iteration: %v", err))
+	}
+	return last, nil
+}
+
+// matchesFilter applies the ListTasks filter fields to a single task. Trashed
+// tasks still match, so watchers see them being deleted.
+func matchesFilter(filter *pb.Task, task *pb.Task) bool {
+	if filter == nil {
+		return true
+	}
+	contains := func(value, part string) bool {
+		part = strings.TrimSpace(part)
+		return part == "" || strings.Contains(strings.ToLower(value), strings.ToLower(part))
+	}
+	if !contains(task.GetTitle(), filter.Title) ||
+		!contains(task.GetDescription(), filter.Description) ||
+		!contains(task.GetExitCriteria(), filter.ExitCriteria) {
+		return false
+	}
+	if filter.Deadline > 0 && task.GetDeadline() != filter.Deadline {
+		return false
+	}
+	if filter.Complete && !task.GetComplete() {
+		return false
+	}
+	if list := strings.TrimSpace(filter.List); list != "" && task.GetList() != list {
+		return false
+	}
+	for _, tag := range normalizeTags(filter.Tags) {
+		if !slices.Contains(task.GetTags(), tag) {
+			return false
+		}
+	}
+	if filter.Priority != pb.Priority_PRIORITY_UNSPECIFIED && task.GetPriority() != filter.Priority {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	pb "taskify/backend/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startTestGRPCServer serves s over an in-memory listener and returns a client.
+func startTestGRPCServer(t *testing.T, s *Server) pb.TaskServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterTaskServiceServer(grpcServer, s)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to dial the test server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewTaskServiceClient(conn)
+}
+
+// waitForSubscribers blocks until n watchers are subscribed to the bus.
+func waitForSubscribers(t *testing.T, s *Server, n int) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		s.bus().mu.Lock()
+		count := len(s.bus().subscribers)
+		s.bus().mu.Unlock()
+		if count >= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("No watcher subscribed")
+}
+
+func TestWatchTasks(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	client := startTestGRPCServer(t, testServer)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchTasks(ctx, &pb.WatchTasksRequest{Filter: &pb.Task{Title: "watched"}})
+	if err != nil {
+		t.Fatalf("WatchTasks: %v", err)
+	}
+	waitForSubscribers(t, testServer, 1)
+
+	createTestTask(t, testServer, "Ignored Task")
+	task := createTestTask(t, testServer, "Watched Task")
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	for _, want := range []pb.EventType{pb.EventType_TASK_CREATED, pb.EventType_TASK_DELETED} {
+		event, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		if event.Type != want || event.Task.TaskId != task.TaskId {
+			t.Errorf("Got %v for task %d, want %v for task %d", event.Type, event.Task.TaskId, want, task.TaskId)
+		}
+	}
+}
+
+func TestWatchTasks_Resume(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	client := startTestGRPCServer(t, testServer)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	first := createTestTask(t, testServer, "First Task")
+	second := createTestTask(t, testServer, "Second Task")
+	history, err := testServer.GetTaskHistory(ctx, &pb.TaskRequest{Task: first})
+	if err != nil {
+		t.Fatalf("GetTaskHistory: %v", err)
+	}
+	token := history.Changes[0].HistoryId
+
+	// The client saw the first creation, then disconnected
+	stream, err := client.WatchTasks(ctx, &pb.WatchTasksRequest{ResumeToken: strconv.FormatInt(token, 10)})
+	if err != nil {
+		t.Fatalf("WatchTasks: %v", err)
+	}
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	if event.Task.TaskId != second.TaskId || event.Type != pb.EventType_TASK_CREATED {
+		t.Errorf("Replayed %v of task %d, want the creation of task %d", event.Type, event.Task.TaskId, second.TaskId)
+	}
+
+	waitForSubscribers(t, testServer, 1)
+	third := createTestTask(t, testServer, "Third Task")
+	event, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	if event.Task.TaskId != third.TaskId {
+		t.Errorf("Got task %d after the replay, want the live creation of task %d", event.Task.TaskId, third.TaskId)
+	}
+}
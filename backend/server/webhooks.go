@@ -0,0 +1,516 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "taskify/backend/proto"
+)
+
+// Webhook delivery headers
+const (
+	WebhookSignatureHeader = "X-Taskify-Signature" // "sha256=" followed by the hex HMAC-SHA256 of the body
+	WebhookEventHeader     = "X-Taskify-Event"     // EventType name of the delivered event
+	WebhookDeliveryHeader  = "X-Taskify-Delivery"  // deliveryId, the same on every attempt
+)
+
+// Delivery statuses
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryDead      = "dead"
+)
+
+const (
+	// DefaultWebhookTimeout bounds a single delivery attempt.
+	DefaultWebhookTimeout = 10 * time.Second
+	// MaxWebhookAttempts is how many times a delivery is tried before it becomes a dead letter.
+	MaxWebhookAttempts = 8
+	// webhookBaseBackoff is the delay before the first retry, doubled after every failed attempt.
+	webhookBaseBackoff = 30 * time.Second
+	// webhookMaxBackoff caps the delay between retries.
+	webhookMaxBackoff = time.Hour
+	// webhookBatchSize is how many due deliveries are sent per run.
+	webhookBatchSize = 100
+)
+
+const webhookColumns = "webhookId, url, eventTypes, active, createdAt"
+
+const deliveryColumns = "deliveryId, webhookId, sequence, eventType, status, attempts, responseCode, error, createdAt, nextAttemptAt, deliveredAt"
+
+// scanWebhook reads a row selected with webhookColumns, without the secret.
+func scanWebhook(row rowScanner) (*pb.Webhook, error) {
+	webhook := &pb.Webhook{}
+	var eventTypes string
+	if err := row.Scan(&webhook.WebhookId, &webhook.Url, &eventTypes, &webhook.Active, &webhook.CreatedAt); err != nil {
+		return nil, err
+	}
+	webhook.EventTypes = parseEventTypes(eventTypes)
+	return webhook, nil
+}
+
+// scanDelivery reads a row selected with deliveryColumns.
+func scanDelivery(row rowScanner) (*pb.WebhookDelivery, error) {
+	delivery := &pb.WebhookDelivery{}
+	var eventType string
+	err := row.Scan(&delivery.DeliveryId, &delivery.WebhookId, &delivery.Sequence, &eventType, &delivery.Status, &delivery.Attempts,
+		&delivery.ResponseCode, &delivery.Error, &delivery.CreatedAt, &delivery.NextAttemptAt, &delivery.DeliveredAt)
+	if err != nil {
+		return nil, err
+	}
+	delivery.EventType = pb.EventType(pb.EventType_value[eventType])
+	return delivery, nil
+}
+
+// formatEventTypes stores event types as comma separated names.
+func formatEventTypes(types []pb.EventType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return strings.Join(names, ",")
+}
+
+func parseEventTypes(value string) []pb.EventType {
+	var types []pb.EventType
+	for _, name := range strings.Split(value, ",") {
+		if t, ok := pb.EventType_value[name]; ok {
+			types = append(types, pb.EventType(t))
+		}
+	}
+	return types
+}
+
+// validateWebhook checks the fields a client can set.
+func validateWebhook(webhook *pb.Webhook) error {
+	u, err := url.Parse(webhook.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid webhook url %q, want an absolute http or https URL", webhook.Url)
+	}
+	for _, t := range webhook.EventTypes {
+		if t == pb.EventType_EVENT_TYPE_UNSPECIFIED || pb.EventType_name[int32(t)] == "" {
+			return status.Errorf(codes.InvalidArgument, "invalid webhook event type %v", t)
+		}
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the X-Taskify-Signature value of a delivery body.
+// Receivers compute it with their copy of the secret and compare.
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookSecret() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", status.Errorf(codes.Internal, "generating webhook secret: %v", err)
+	}
+	return hex.EncodeToString(key), nil
+}
+
+func (s *Server) getWebhook(id int64) (*pb.Webhook, error) {
+	webhook, err := scanWebhook(s.Db.QueryRow("SELECT "+webhookColumns+" FROM webhooks WHERE webhookId = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "webhook %d not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reading webhook %d: %v", id, err)
+	}
+	return webhook, nil
+}
+
+// CreateWebhook subscribes a URL to task events. The response is the only
+// place the secret is ever returned.
+func (s *Server) CreateWebhook(ctx context.Context, in *pb.WebhookRequest) (*pb.WebhookResponse, error) {
+	if in == nil || in.Webhook == nil {
+		return nil, status.Error(codes.InvalidArgument, "Webhook is nil")
+	}
+	if err := validateWebhook(in.Webhook); err != nil {
+		return nil, err
+	}
+	secret := in.Webhook.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	createdAt := s.now().Unix()
+	query := "INSERT INTO webhooks (url, secret, eventTypes, active, createdAt) VALUES (?, ?, ?, 1, ?)"
+	res, err := s.Db.Exec(query, in.Webhook.Url, secret, formatEventTypes(in.Webhook.EventTypes), createdAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating webhook: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating webhook: %v", err)
+	}
+	webhook, err := s.getWebhook(id)
+	if err != nil {
+		return nil, err
+	}
+	webhook.Secret = secret
+	return &pb.WebhookResponse{Webhook: webhook}, nil
+}
+
+// UpdateWebhook replaces the url and event types of a webhook, and its active
+// flag and secret if they are given.
+func (s *Server) UpdateWebhook(ctx context.Context, in *pb.WebhookRequest) (*pb.WebhookResponse, error) {
+	if in == nil || in.Webhook == nil {
+		return nil, status.Error(codes.InvalidArgument, "Webhook is nil")
+	}
+	if in.Webhook.WebhookId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "WebhookId is empty")
+	}
+	if err := validateWebhook(in.Webhook); err != nil {
+		return nil, err
+	}
+
+	query := "UPDATE webhooks SET url = ?, eventTypes = ?, active = COALESCE(?, active), secret = COALESCE(NULLIF(?, ''), secret) WHERE webhookId = ?"
+	res, err := s.Db.Exec(query, in.Webhook.Url, formatEventTypes(in.Webhook.EventTypes), in.Webhook.Active, in.Webhook.Secret, in.Webhook.WebhookId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "updating webhook %d: %v", in.Webhook.WebhookId, err)
+	}
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "webhook %d not found", in.Webhook.WebhookId)
+	}
+	webhook, err := s.getWebhook(in.Webhook.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WebhookResponse{Webhook: webhook}, nil
+}
+
+// DeleteWebhook removes a webhook together with its delivery log.
+func (s *Server) DeleteWebhook(ctx context.Context, in *pb.WebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if in == nil || in.Webhook == nil {
+		return nil, status.Error(codes.InvalidArgument, "Webhook is nil")
+	}
+
+	var deleted bool
+	err := s.inTx(func(tx *txn) error {
+		res, err := tx.Exec("DELETE FROM webhooks WHERE webhookId = ?", in.Webhook.WebhookId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "deleting webhook %d: %v", in.Webhook.WebhookId, err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return status.Errorf(codes.Internal, "deleting webhook %d: %v", in.Webhook.WebhookId, err)
+		}
+		if rowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		if _, err := tx.Exec("DELETE FROM webhook_deliveries WHERE webhookId = ?", in.Webhook.WebhookId); err != nil {
+			return status.Errorf(codes.Internal, "deleting deliveries of webhook %d: %v", in.Webhook.WebhookId, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteWebhookResponse{Success: deleted}, nil
+}
+
+// ListWebhooks returns every webhook without its secret
+func (s *Server) ListWebhooks(ctx context.Context, in *pb.WebhookRequest) (*pb.ListWebhooksResponse, error) {
+	rows, err := s.Db.Query("SELECT " + webhookColumns + " FROM webhooks ORDER BY webhookId ASC")
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to query webhooks: %v", err))
+	}
+	defer rows.Close()
+
+	var webhooks []*pb.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to scan row: %v", err))
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Error encountered during iteration: %v", err))
+	}
+	return &pb.ListWebhooksResponse{Webhooks: webhooks}, nil
+}
+
+// ListWebhookDeliveries returns the delivery log, most recent first
+func (s *Server) ListWebhookDeliveries(ctx context.Context, in *pb.WebhookDeliveriesRequest) (*pb.WebhookDeliveriesResponse, error) {
+	if in == nil {
+		in = &pb.WebhookDeliveriesRequest{}
+	}
+	query := "SELECT " + deliveryColumns + " FROM webhook_deliveries WHERE 1=1"
+	var args []any
+	if in.WebhookId != 0 {
+		query += " AND webhookId = ?"
+		args = append(args, in.WebhookId)
+	}
+	if in.DeadOnly {
+		query += " AND status = ?"
+		args = append(args, DeliveryDead)
+	}
+	query += " ORDER BY deliveryId DESC"
+
+	deliveries, err := s.queryDeliveries(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WebhookDeliveriesResponse{Deliveries: deliveries}, nil
+}
+
+// RetryWebhookDelivery gives a dead letter a fresh set of attempts, starting now.
+func (s *Server) RetryWebhookDelivery(ctx context.Context, in *pb.WebhookDeliveriesRequest) (*pb.WebhookDeliveriesResponse, error) {
+	if in == nil || in.DeliveryId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "DeliveryId is empty")
+	}
+	query := "UPDATE webhook_deliveries SET status = ?, attempts = 0, nextAttemptAt = ? WHERE deliveryId = ? AND status = ?"
+	res, err := s.Db.Exec(query, DeliveryPending, s.now().Unix(), in.DeliveryId, DeliveryDead)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "retrying delivery %d: %v", in.DeliveryId, err)
+	}
+	if rowsAffected, err := res.RowsAffected(); err != nil || rowsAffected == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "delivery %d is not a dead letter", in.DeliveryId)
+	}
+	deliveries, err := s.queryDeliveries("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE deliveryId = ?", in.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WebhookDeliveriesResponse{Deliveries: deliveries}, nil
+}
+
+func (s *Server) queryDeliveries(query string, args ...any) ([]*pb.WebhookDelivery, error) {
+	rows, err := s.Db.Query(query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to query deliveries: %v", err))
+	}
+	defer rows.Close()
+
+	var deliveries []*pb.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("Failed to scan row: %v", err))
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("Error encountered during iteration: %v", err))
+	}
+	return deliveries, nil
+}
+
+// queueWebhookDeliveries adds a pending delivery for every event of tx and
+// every active webhook subscribed to it, so a committed change is never lost
+// even if the process stops before it is sent.
+func queueWebhookDeliveries(tx *txn) error {
+	if len(tx.events) == 0 {
+		return nil
+	}
+	rows, err := tx.Query("SELECT webhookId, eventTypes FROM webhooks WHERE active = 1")
+	if err != nil {
+		return status.Errorf(codes.Internal, "reading webhooks: %v", err)
+	}
+	type subscription struct {
+		id    int64
+		types []pb.EventType
+	}
+	var subscriptions []subscription
+	for rows.Next() {
+		var sub subscription
+		var eventTypes string
+		if err := rows.Scan(&sub.id, &eventTypes); err != nil {
+			rows.Close()
+			return status.Errorf(codes.Internal, "reading webhooks: %v", err)
+		}
+		sub.types = parseEventTypes(eventTypes)
+		subscriptions = append(subscriptions, sub)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return status.Errorf(codes.Internal, "reading webhooks: %v", err)
+	}
+
+	now := tx.now.Unix()
+	for _, event := range tx.events {
+		var payload []byte
+		for _, sub := range subscriptions {
+			if !subscribedTo(sub.types, event.Type) {
+				continue
+			}
+			if payload == nil {
+				if payload, err = protojson.Marshal(event); err != nil {
+					return status.Errorf(codes.Internal, "encoding event %d: %v", event.Sequence, err)
+				}
+			}
+			query := `INSERT INTO webhook_deliveries (webhookId, sequence, eventType, payload, status, createdAt, nextAttemptAt)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`
+			if _, err := tx.Exec(query, sub.id, event.Sequence, event.Type.String(), string(payload), DeliveryPending, now, now); err != nil {
+				return status.Errorf(codes.Internal, "queueing webhook delivery: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// subscribedTo reports whether a webhook listening to types wants an event of type t.
+func subscribedTo(types []pb.EventType, t pb.EventType) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, want := range types {
+		if want == t {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookBackoff is the delay before retrying a delivery that failed attempts times.
+func webhookBackoff(attempts int32) time.Duration {
+	delay := webhookBaseBackoff
+	for i := int32(1); i < attempts && delay < webhookMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookMaxBackoff)
+}
+
+func (s *Server) webhookClient() *http.Client {
+	if s.WebhookClient == nil {
+		return &http.Client{Timeout: DefaultWebhookTimeout}
+	}
+	return s.WebhookClient
+}
+
+// dueDelivery is a pending delivery with what is needed to send it.
+type dueDelivery struct {
+	id        int64
+	eventType string
+	payload   string
+	attempts  int32
+	url       string
+	secret    string
+}
+
+// DeliverWebhooks sends the deliveries that are due and returns how many were accepted.
+func (s *Server) DeliverWebhooks(ctx context.Context) (int, error) {
+	now := s.now()
+	query := `SELECT d.deliveryId, d.eventType, d.payload, d.attempts, w.url, w.secret
+		FROM webhook_deliveries d JOIN webhooks w ON w.webhookId = d.webhookId
+		WHERE d.status = ? AND d.nextAttemptAt <= ? ORDER BY d.deliveryId ASC LIMIT ?`
+	rows, err := s.Db.Query(query, DeliveryPending, now.Unix(), webhookBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("reading due deliveries: %w", err)
+	}
+	// Read everything first, the single connection is needed to record the outcomes
+	var due []dueDelivery
+	for rows.Next() {
+		var d dueDelivery
+		if err := rows.Scan(&d.id, &d.eventType, &d.payload, &d.attempts, &d.url, &d.secret); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("reading due deliveries: %w", err)
+		}
+		due = append(due, d)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("reading due deliveries: %w", err)
+	}
+
+	delivered := 0
+	for _, d := range due {
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+		code, sendErr := s.sendWebhook(ctx, d)
+		attempts := d.attempts + 1
+		if sendErr == nil {
+			delivered++
+			query := "UPDATE webhook_deliveries SET status = ?, attempts = ?, responseCode = ?, error = '', deliveredAt = ? WHERE deliveryId = ?"
+			_, err = s.Db.Exec(query, DeliveryDelivered, attempts, code, now.Unix(), d.id)
+		} else {
+			nextStatus, nextAttempt := DeliveryPending, now.Add(webhookBackoff(attempts)).Unix()
+			if attempts >= MaxWebhookAttempts {
+				nextStatus, nextAttempt = DeliveryDead, 0
+			}
+			query := "UPDATE webhook_deliveries SET status = ?, attempts = ?, responseCode = ?, error = ?, nextAttemptAt = ? WHERE deliveryId = ?"
+			_, err = s.Db.Exec(query, nextStatus, attempts, code, sendErr.Error(), nextAttempt, d.id)
+		}
+		if err != nil {
+			return delivered, fmt.Errorf("recording delivery %d: %w", d.id, err)
+		}
+	}
+	return delivered, nil
+}
+
+// sendWebhook POSTs a delivery and returns the response status. Any status
+// other than 2xx is an error.
+func (s *Server) sendWebhook(ctx context.Context, d dueDelivery) (int32, error) {
+	body := []byte(d.payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Taskify-Webhook")
+	req.Header.Set(WebhookEventHeader, d.eventType)
+	req.Header.Set(WebhookDeliveryHeader, strconv.FormatInt(d.id, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(d.secret, body))
+
+	resp, err := s.webhookClient().Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return int32(resp.StatusCode), fmt.Errorf("receiver responded %s", resp.Status)
+	}
+	return int32(resp.StatusCode), nil
+}
+
+// StartWebhookDispatcher sends due deliveries right after every change and
+// retries the failed ones every interval until ctx is cancelled.
+func (s *Server) StartWebhookDispatcher(ctx context.Context, interval time.Duration) {
+	ticker := s.timeSource().NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		sub := s.bus().Subscribe()
+		defer func() { sub.Close() }()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C():
+			case _, ok := <-sub.Events():
+				if !ok {
+					// Dropped for falling behind, the deliveries are in the database anyway
+					sub = s.bus().Subscribe()
+				}
+			}
+			if _, err := s.DeliverWebhooks(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Webhook delivery failed: %v", err)
+			}
+		}
+	}()
+}
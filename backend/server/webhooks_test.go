@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// webhookReceiver records the deliveries it accepts and answers with code.
+type webhookReceiver struct {
+	mu     sync.Mutex
+	code   int
+	events []*pb.TaskEvent
+	header []http.Header
+	bodies [][]byte
+}
+
+func startWebhookReceiver(t *testing.T, code int) (*webhookReceiver, string) {
+	t.Helper()
+	receiver := &webhookReceiver{code: code}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		event := &pb.TaskEvent{}
+		if err := protojson.Unmarshal(body, event); err != nil {
+			t.Errorf("Delivery body is not a TaskEvent: %v", err)
+		}
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.events = append(receiver.events, event)
+		receiver.header = append(receiver.header, r.Header.Clone())
+		receiver.bodies = append(receiver.bodies, body)
+		w.WriteHeader(receiver.code)
+	}))
+	t.Cleanup(ts.Close)
+	return receiver, ts.URL
+}
+
+func createTestWebhook(t *testing.T, s *Server, webhook *pb.Webhook) *pb.Webhook {
+	t.Helper()
+	res, err := s.CreateWebhook(context.Background(), &pb.WebhookRequest{Webhook: webhook})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	return res.Webhook
+}
+
+func TestWebhooks_SignedDelivery(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	receiver, url := startWebhookReceiver(t, http.StatusOK)
+	webhook := createTestWebhook(t, testServer, &pb.Webhook{Url: url})
+	if webhook.Secret == "" {
+		t.Fatalf("CreateWebhook did not generate a secret")
+	}
+
+	task := createTestTask(t, testServer, "Hooked Task")
+	task.Complete = true
+	if _, err := testServer.UpdateTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	delivered, err := testServer.DeliverWebhooks(ctx)
+	if err != nil || delivered != 2 {
+		t.Fatalf("DeliverWebhooks() = %d, %v, want 2 deliveries", delivered, err)
+	}
+	for i, want := range []pb.EventType{pb.EventType_TASK_CREATED, pb.EventType_TASK_COMPLETED} {
+		if receiver.events[i].Type != want || receiver.events[i].Task.TaskId != task.TaskId {
+			t.Errorf("Delivery %d is %v for task %d, want %v for task %d", i, receiver.events[i].Type, receiver.events[i].Task.TaskId, want, task.TaskId)
+		}
+		if got, want := receiver.header[i].Get(WebhookSignatureHeader), SignWebhookPayload(webhook.Secret, receiver.bodies[i]); got != want {
+			t.Errorf("Delivery %d signature = %q, want %q", i, got, want)
+		}
+	}
+
+	listed, err := testServer.ListWebhooks(ctx, &pb.WebhookRequest{})
+	if err != nil || len(listed.Webhooks) != 1 || listed.Webhooks[0].Secret != "" {
+		t.Errorf("ListWebhooks() = %v, %v, want the webhook without its secret", listed, err)
+	}
+}
+
+func TestWebhooks_EventTypeFilter(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	receiver, url := startWebhookReceiver(t, http.StatusNoContent)
+	createTestWebhook(t, testServer, &pb.Webhook{Url: url, EventTypes: []pb.EventType{pb.EventType_TASK_DELETED}})
+
+	task := createTestTask(t, testServer, "Filtered Task")
+	if _, err := testServer.DeleteTask(ctx, &pb.TaskRequest{Task: task}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if _, err := testServer.DeliverWebhooks(ctx); err != nil {
+		t.Fatalf("DeliverWebhooks: %v", err)
+	}
+	if len(receiver.events) != 1 || receiver.events[0].Type != pb.EventType_TASK_DELETED {
+		t.Errorf("Received %v, want only the TASK_DELETED event", receiver.events)
+	}
+}
+
+func TestUpdateWebhook_Active(t *testing.T) {
+	ctx := context.Background()
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	receiver, url := startWebhookReceiver(t, http.StatusNoContent)
+	webhook := createTestWebhook(t, testServer, &pb.Webhook{Url: url})
+	deliver := func(title string) {
+		t.Helper()
+		createTestTask(t, testServer, title)
+		if _, err := testServer.DeliverWebhooks(ctx); err != nil {
+			t.Fatalf("DeliverWebhooks: %v", err)
+		}
+	}
+
+	// An update that leaves out the active flag keeps it
+	webhook.EventTypes, webhook.Active = []pb.EventType{pb.EventType_TASK_CREATED}, nil
+	if _, err := testServer.UpdateWebhook(ctx, &pb.WebhookRequest{Webhook: webhook}); err != nil {
+		t.Fatalf("UpdateWebhook: %v", err)
+	}
+	deliver("Delivered Task")
+
+	webhook.Active = proto.Bool(false)
+	if _, err := testServer.UpdateWebhook(ctx, &pb.WebhookRequest{Webhook: webhook}); err != nil {
+		t.Fatalf("UpdateWebhook: %v", err)
+	}
+	deliver("Skipped Task")
+	if len(receiver.events) != 1 || receiver.events[0].Task.Title != "Delivered Task" {
+		t.Errorf("Received %v, want only the event of the task created while active", receiver.events)
+	}
+}
+
+func TestWebhooks_RetryAndDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Now())
+	testServer := &Server{Db: initializeTestingDatabase(t), Clock: fake}
+	receiver, url := startWebhookReceiver(t, http.StatusInternalServerError)
+	createTestWebhook(t, testServer, &pb.Webhook{Url: url})
+	createTestTask(t, testServer, "Failing Task")
+
+	for attempt := 1; attempt <= MaxWebhookAttempts; attempt++ {
+		if _, err := testServer.DeliverWebhooks(ctx); err != nil {
+			t.Fatalf("DeliverWebhooks: %v", err)
+		}
+		if len(receiver.events) != attempt {
+			t.Fatalf("Attempt %d: receiver got %d deliveries", attempt, len(receiver.events))
+		}
+		// A retry is not due before its backoff has passed
+		fake.Advance(webhookBackoff(int32(attempt)) - time.Second)
+		if _, err := testServer.DeliverWebhooks(ctx); err != nil {
+			t.Fatalf("DeliverWebhooks: %v", err)
+		}
+		if len(receiver.events) != attempt {
+			t.Fatalf("Attempt %d was retried before its backoff", attempt)
+		}
+		fake.Advance(time.Second)
+	}
+
+	dead, err := testServer.ListWebhookDeliveries(ctx, &pb.WebhookDeliveriesRequest{DeadOnly: true})
+	if err != nil {
+		t.Fatalf("ListWebhookDeliveries: %v", err)
+	}
+	if len(dead.Deliveries) != 1 || dead.Deliveries[0].Attempts != MaxWebhookAttempts || dead.Deliveries[0].ResponseCode != http.StatusInternalServerError {
+		t.Fatalf("Dead letters = %v, want one delivery that used every attempt", dead.Deliveries)
+	}
+
+	receiver.mu.Lock()
+	receiver.code = http.StatusOK
+	receiver.mu.Unlock()
+	if _, err := testServer.RetryWebhookDelivery(ctx, &pb.WebhookDeliveriesRequest{DeliveryId: dead.Deliveries[0].DeliveryId}); err != nil {
+		t.Fatalf("RetryWebhookDelivery: %v", err)
+	}
+	if delivered, err := testServer.DeliverWebhooks(ctx); err != nil || delivered != 1 {
+		t.Fatalf("DeliverWebhooks() = %d, %v, want the retried delivery", delivered, err)
+	}
+	deliveries, err := testServer.ListWebhookDeliveries(ctx, &pb.WebhookDeliveriesRequest{})
+	if err != nil || len(deliveries.Deliveries) != 1 || deliveries.Deliveries[0].Status != DeliveryDelivered {
+		t.Errorf("ListWebhookDeliveries() = %v, %v, want the delivery delivered", deliveries, err)
+	}
+
+	_, err = testServer.RetryWebhookDelivery(ctx, &pb.WebhookDeliveriesRequest{DeliveryId: dead.Deliveries[0].DeliveryId})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Retrying a delivered delivery returned %v, want FailedPrecondition", err)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	for attempts, want := range map[int32]time.Duration{1: 30 * time.Second, 2: time.Minute, 4: 4 * time.Minute, 20: time.Hour} {
+		if got := webhookBackoff(attempts); got != want {
+			t.Errorf("webhookBackoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+func TestCreateWebhook_InvalidURL(t *testing.T) {
+	testServer := &Server{Db: initializeTestingDatabase(t)}
+	_, err := testServer.CreateWebhook(context.Background(), &pb.WebhookRequest{Webhook: &pb.Webhook{Url: "ftp://example.com"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateWebhook(ftp) returned %v, want InvalidArgument", err)
+	}
+}
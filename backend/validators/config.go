@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Duration is a time.Duration written as a string like "5m" in config files.
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("duration must be a string like \"5m\": %w", err)
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// Config holds the limits every task is checked against. Fields are named
+// like the JSON fields of the Task proto, e.g. "exitCriteria".
+type Config struct {
+	Required         []string             `json:"required"`         // Fields that must be set
+	MaxLengths       map[string]int       `json:"maxLengths"`       // Most characters allowed in a text field
+	DeadlineGrace    Duration             `json:"deadlineGrace"`    // How far in the past a deadline may be, to allow for clock skew
+	DeadlineMaxAhead Duration             `json:"deadlineMaxAhead"` // How far in the future a deadline may be, no limit if zero
+	Lists            map[string]ListRules `json:"lists"`            // Extra rules for the tasks of a list, keyed by list name
+}
+
+// ListRules are checked in addition to the Config for the tasks of one list.
+type ListRules struct {
+	Required         []string          `json:"required"`
+	MaxLengths       map[string]int    `json:"maxLengths"`
+	Patterns         map[string]string `json:"patterns"`         // Regular expressions text fields must match
+	DeadlineMaxAhead Duration          `json:"deadlineMaxAhead"` // A tighter deadline limit for the list, none if zero
+}
+
+// DefaultConfig returns the limits used when no config file is given.
+func DefaultConfig() Config {
+	return Config{
+		Required: []string{"title", "description", "exitCriteria", "deadline"},
+		MaxLengths: map[string]int{
+			"title":        255,
+			"description":  10000,
+			"exitCriteria": 10000,
+			"list":         100,
+			"assignee":     100,
+		},
+		DeadlineGrace:    Duration(5 * time.Minute),
+		DeadlineMaxAhead: Duration(10 * 365 * 24 * time.Hour),
+	}
+}
+
+// LoadConfig reads a JSON config file. Settings missing from the file keep
+// their DefaultConfig value, max lengths are merged with the defaults.
+func LoadConfig(path string) (Config, error) {
+	config := DefaultConfig()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("reading validation config: %w", err)
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("parsing validation config %s: %w", path, err)
+	}
+	return config, nil
+}
@@ -0,0 +1,63 @@
+package validators
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Violations collects the invalid fields of a request. Fields are named like
+// the JSON fields of the proto, e.g. "exitCriteria".
+type Violations struct {
+	list []*errdetails.BadRequest_FieldViolation
+}
+
+// Add records that field is invalid and why.
+func (v *Violations) Add(field, description string) {
+	v.list = append(v.list, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+}
+
+// Err returns an InvalidArgument status carrying every violation as
+// errdetails.BadRequest, or nil if there are none.
+func (v *Violations) Err() error {
+	if len(v.list) == 0 {
+		return nil
+	}
+	descriptions := make([]string, len(v.list))
+	for i, violation := range v.list {
+		descriptions[i] = violation.Description
+	}
+	st := status.New(codes.InvalidArgument, strings.Join(descriptions, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v.list})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// FieldError returns the error of a request with a single invalid field.
+func FieldError(field, description string) error {
+	var v Violations
+	v.Add(field, description)
+	return v.Err()
+}
+
+// FieldViolations returns the description of every invalid field carried by
+// err, keyed by field name. It is empty for errors without field violations.
+func FieldViolations(err error) map[string]string {
+	violations := map[string]string{}
+	for _, detail := range status.Convert(err).Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.FieldViolations {
+			if _, seen := violations[violation.Field]; !seen {
+				violations[violation.Field] = violation.Description
+			}
+		}
+	}
+	return violations
+}
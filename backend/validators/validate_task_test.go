@@ -38,7 +38,7 @@ func TestValidateTask(t *testing.T) {
 				ExitCriteria: "Exit Criteria",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.InvalidArgument, "title is empty"),
+			expectedError: FieldError("title", "title is empty"),
 		},
 		{
 			name: "missing description",
@@ -48,7 +48,7 @@ func TestValidateTask(t *testing.T) {
 				ExitCriteria: "Exit Criteria",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.InvalidArgument, "description is empty"),
+			expectedError: FieldError("description", "description is empty"),
 		},
 		{
 			name: "missing exit criteria",
@@ -58,7 +58,7 @@ func TestValidateTask(t *testing.T) {
 				Deadline:    time.Now().Add(24 * time.Hour).Unix(),
 				Complete:    false,
 			},
-			expectedError: status.Error(codes.InvalidArgument, "exit criteria is empty"),
+			expectedError: FieldError("exitCriteria", "exit criteria is empty"),
 		},
 		{
 			name: "deadline in the past",
@@ -69,7 +69,7 @@ func TestValidateTask(t *testing.T) {
 				ExitCriteria: "Exit Criteria",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.InvalidArgument, "deadline is set in the past"),
+			expectedError: FieldError("deadline", "deadline is set in the past"),
 		},
 		{
 			name: "deadline in the future",
@@ -80,7 +80,7 @@ func TestValidateTask(t *testing.T) {
 				ExitCriteria: "Exit Criteria",
 				Complete:     false,
 			},
-			expectedError: status.Error(codes.InvalidArgument, "deadline is unreasonably far in the future"),
+			expectedError: FieldError("deadline", "deadline is unreasonably far in the future"),
 		},
 		{
 			name: "complete set to true when creating",
@@ -91,7 +91,7 @@ func TestValidateTask(t *testing.T) {
 				ExitCriteria: "Exit Criteria",
 				Complete:     true,
 			},
-			expectedError: status.Error(codes.InvalidArgument, "a new task cannot be marked as complete"),
+			expectedError: FieldError("complete", "a new task cannot be marked as complete"),
 		},
 	}
 	for _, tc := range testCases {
@@ -107,3 +107,19 @@ func TestValidateTask(t *testing.T) {
 	}
 
 }
+
+func TestValidateTask_ReportsEveryField(t *testing.T) {
+	err := ValidateTask(&pb.Task{Deadline: time.Now().Add(time.Hour).Unix(), Complete: true}, false)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ValidateTask returned %v, want InvalidArgument", err)
+	}
+	want := map[string]string{
+		"title":        "title is empty",
+		"description":  "description is empty",
+		"exitCriteria": "exit criteria is empty",
+		"complete":     "a new task cannot be marked as complete",
+	}
+	if diff := cmp.Diff(want, FieldViolations(err)); diff != "" {
+		t.Errorf("FieldViolations (-want,+got):%v", diff)
+	}
+}
@@ -0,0 +1,265 @@
+package validators
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+)
+
+// Rule checks one aspect of a task and adds what is wrong with it to v. before
+// is the stored task when it is an update and nil for a new task.
+type Rule func(task, before *pb.Task, v *Violations)
+
+// Validator checks tasks against the rules built from a Config. It is the
+// single place the service decides whether a task may be stored.
+type Validator struct {
+	rules []Rule
+	lists map[string][]Rule
+	clock clock.Clock
+}
+
+// Option customizes a Validator.
+type Option func(*Validator)
+
+// WithClock makes deadlines be checked against c instead of the wall clock.
+func WithClock(c clock.Clock) Option {
+	return func(v *Validator) {
+		v.clock = c
+	}
+}
+
+var taskFields = (&pb.Task{}).ProtoReflect().Descriptor().Fields()
+
+// New builds a validator, failing on fields and patterns that don't exist or don't compile.
+func New(config Config, options ...Option) (*Validator, error) {
+	v := &Validator{lists: map[string][]Rule{}, clock: clock.Real()}
+	for _, option := range options {
+		option(v)
+	}
+
+	rules, err := fieldRules(config.Required, config.MaxLengths, nil)
+	if err != nil {
+		return nil, err
+	}
+	v.rules = append(rules, v.deadlineRule(time.Duration(config.DeadlineGrace), time.Duration(config.DeadlineMaxAhead)), newTaskRule, tagsRule, priorityRule, startRule, estimateRule, dependsOnRule)
+
+	for list, listRules := range config.Lists {
+		rules, err := fieldRules(listRules.Required, listRules.MaxLengths, listRules.Patterns)
+		if err != nil {
+			return nil, fmt.Errorf("list %q: %w", list, err)
+		}
+		if ahead := time.Duration(listRules.DeadlineMaxAhead); ahead > 0 {
+			rules = append(rules, v.listDeadlineRule(list, ahead))
+		}
+		v.lists[list] = rules
+	}
+	return v, nil
+}
+
+// defaultValidator checks tasks against DefaultConfig.
+var defaultValidator = func() *Validator {
+	v, err := New(DefaultConfig())
+	if err != nil {
+		panic(err)
+	}
+	return v
+}()
+
+// Default returns the validator of DefaultConfig.
+func Default() *Validator {
+	return defaultValidator
+}
+
+// ValidateTask reports every invalid field of a task at once as an
+// InvalidArgument error with field violations. The tasks of a list with its
+// own rules are checked against those too. Updates are checked as if every
+// field changed, use ValidateUpdate when the stored task is known.
+func (val *Validator) ValidateTask(task *pb.Task, isUpdate bool) error {
+	var before *pb.Task
+	if isUpdate {
+		before = &pb.Task{}
+	}
+	return val.ValidateUpdate(task, before)
+}
+
+// ValidateUpdate checks a change to the stored task before, or a new task if
+// before is nil. Fields the change keeps, like a deadline that has passed
+// since, are not held against it.
+func (val *Validator) ValidateUpdate(task, before *pb.Task) error {
+	var v Violations
+	for _, rule := range val.rules {
+		rule(task, before, &v)
+	}
+	for _, rule := range val.lists[strings.TrimSpace(task.List)] {
+		rule(task, before, &v)
+	}
+	return v.Err()
+}
+
+// fieldRules builds the required, length and pattern rules of a rule set.
+func fieldRules(required []string, maxLengths map[string]int, patterns map[string]string) ([]Rule, error) {
+	var rules []Rule
+	for _, name := range required {
+		field, err := taskField(name, false)
+		if err != nil {
+			return nil, fmt.Errorf("required: %w", err)
+		}
+		rules = append(rules, requiredRule(field))
+	}
+	for _, name := range slices.Sorted(maps.Keys(maxLengths)) {
+		max := maxLengths[name]
+		field, err := taskField(name, true)
+		if err != nil {
+			return nil, fmt.Errorf("maxLengths: %w", err)
+		}
+		rules = append(rules, maxLengthRule(field, max))
+	}
+	for _, name := range slices.Sorted(maps.Keys(patterns)) {
+		pattern := patterns[name]
+		field, err := taskField(name, true)
+		if err != nil {
+			return nil, fmt.Errorf("patterns: %w", err)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("patterns: %s: %w", name, err)
+		}
+		rules = append(rules, patternRule(field, re))
+	}
+	return rules, nil
+}
+
+// taskField looks up a Task field by its JSON name.
+func taskField(name string, text bool) (protoreflect.FieldDescriptor, error) {
+	field := taskFields.ByJSONName(name)
+	if field == nil {
+		return nil, fmt.Errorf("task has no field %q", name)
+	}
+	if text && field.Kind() != protoreflect.StringKind {
+		return nil, fmt.Errorf("field %q is not text", name)
+	}
+	return field, nil
+}
+
+// fieldLabel turns a field name like exitCriteria into "exit criteria".
+func fieldLabel(field protoreflect.FieldDescriptor) string {
+	var label strings.Builder
+	for _, r := range field.JSONName() {
+		if unicode.IsUpper(r) {
+			label.WriteRune(' ')
+		}
+		label.WriteRune(unicode.ToLower(r))
+	}
+	return label.String()
+}
+
+func requiredRule(field protoreflect.FieldDescriptor) Rule {
+	return func(task, before *pb.Task, v *Violations) {
+		value := task.ProtoReflect().Get(field)
+		switch {
+		case field.Kind() == protoreflect.StringKind:
+			if strings.TrimSpace(value.String()) == "" {
+				v.Add(field.JSONName(), fieldLabel(field)+" is empty")
+			}
+		case !task.ProtoReflect().Has(field):
+			v.Add(field.JSONName(), fieldLabel(field)+" is missing")
+		}
+	}
+}
+
+func maxLengthRule(field protoreflect.FieldDescriptor, max int) Rule {
+	return func(task, before *pb.Task, v *Violations) {
+		if utf8.RuneCountInString(task.ProtoReflect().Get(field).String()) > max {
+			v.Add(field.JSONName(), fmt.Sprintf("%s is longer than %d characters", fieldLabel(field), max))
+		}
+	}
+}
+
+func patternRule(field protoreflect.FieldDescriptor, re *regexp.Regexp) Rule {
+	return func(task, before *pb.Task, v *Violations) {
+		if !re.MatchString(task.ProtoReflect().Get(field).String()) {
+			v.Add(field.JSONName(), fmt.Sprintf("%s must match %s", fieldLabel(field), re))
+		}
+	}
+}
+
+// deadlineRule keeps deadlines between grace in the past and maxAhead in the
+// future. An overdue task can still be changed, e.g. completed, as long as the
+// change keeps its deadline.
+func (val *Validator) deadlineRule(grace, maxAhead time.Duration) Rule {
+	return func(task, before *pb.Task, v *Violations) {
+		if task.Deadline == 0 {
+			return // Only the required rule decides whether a deadline is needed
+		}
+		now := val.clock.Now()
+		if task.Deadline < now.Add(-grace).Unix() && (before == nil || before.Deadline != task.Deadline) {
+			v.Add("deadline", "deadline is set in the past")
+		}
+		if maxAhead > 0 && task.Deadline > now.Add(maxAhead).Unix() {
+			v.Add("deadline", "deadline is unreasonably far in the future")
+		}
+	}
+}
+
+func (val *Validator) listDeadlineRule(list string, maxAhead time.Duration) Rule {
+	return func(task, before *pb.Task, v *Violations) {
+		if task.Deadline > val.clock.Now().Add(maxAhead).Unix() {
+			v.Add("deadline", fmt.Sprintf("deadline is more than %v away, the limit of list %q", maxAhead, list))
+		}
+	}
+}
+
+// tagsRule rejects tags that are not a single word, they are stored separated by spaces.
+func tagsRule(task, before *pb.Task, v *Violations) {
+	for _, tag := range task.Tags {
+		if tag == "" || strings.IndexFunc(tag, unicode.IsSpace) >= 0 {
+			v.Add("tags", fmt.Sprintf("tag %q is not a single word", tag))
+		}
+	}
+}
+
+// priorityRule rejects priorities the proto does not define.
+func priorityRule(task, before *pb.Task, v *Violations) {
+	if _, ok := pb.Priority_name[int32(task.Priority)]; !ok {
+		v.Add("priority", fmt.Sprintf("priority %d does not exist", task.Priority))
+	}
+}
+
+// startRule keeps the start of a task at or before its deadline.
+func startRule(task, before *pb.Task, v *Violations) {
+	if task.StartAt != 0 && task.Deadline != 0 && task.StartAt > task.Deadline {
+		v.Add("startAt", "start is after the deadline")
+	}
+}
+
+// estimateRule keeps estimates between 0, unknown, and a year of minutes.
+func estimateRule(task, before *pb.Task, v *Violations) {
+	if task.EstimateMinutes < 0 || task.EstimateMinutes > 365*24*60 {
+		v.Add("estimateMinutes", "estimate is not between 0 and a year")
+	}
+}
+
+// dependsOnRule keeps a task from depending on itself. Whether the tasks it
+// depends on exist is checked where they are stored.
+func dependsOnRule(task, before *pb.Task, v *Violations) {
+	if task.TaskId != 0 && slices.Contains(task.DependsOn, task.TaskId) {
+		v.Add("dependsOn", "a task cannot depend on itself")
+	}
+}
+
+// newTaskRule rejects tasks that are created already complete.
+func newTaskRule(task, before *pb.Task, v *Violations) {
+	if before == nil && task.Complete {
+		v.Add("complete", "a new task cannot be marked as complete")
+	}
+}
@@ -0,0 +1,159 @@
+package validators
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"taskify/backend/clock"
+	pb "taskify/backend/proto"
+)
+
+func validTask() *pb.Task {
+	return &pb.Task{
+		Title:        "Title",
+		Description:  "Description",
+		Deadline:     time.Now().Add(24 * time.Hour).Unix(),
+		ExitCriteria: "Exit Criteria",
+	}
+}
+
+func TestValidator_ListRules(t *testing.T) {
+	config := DefaultConfig()
+	config.Lists = map[string]ListRules{
+		"release": {
+			Required:         []string{"list"},
+			MaxLengths:       map[string]int{"title": 10},
+			Patterns:         map[string]string{"title": `^REL-\d+`},
+			DeadlineMaxAhead: Duration(7 * 24 * time.Hour),
+		},
+	}
+	v, err := New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if err := v.ValidateTask(validTask(), false); err != nil {
+		t.Errorf("A task outside the list was rejected: %v", err)
+	}
+
+	task := validTask()
+	task.List = "release"
+	task.Title = "Ship the release"
+	task.Deadline = time.Now().Add(30 * 24 * time.Hour).Unix()
+	want := map[string]string{
+		"title":    "title is longer than 10 characters",
+		"deadline": `deadline is more than 168h0m0s away, the limit of list "release"`,
+	}
+	if diff := cmp.Diff(want, FieldViolations(v.ValidateTask(task, false))); diff != "" {
+		t.Errorf("FieldViolations (-want,+got):%v", diff)
+	}
+
+	task.Title = "REL-12"
+	task.Deadline = time.Now().Add(24 * time.Hour).Unix()
+	if err := v.ValidateTask(task, false); err != nil {
+		t.Errorf("A valid release task was rejected: %v", err)
+	}
+}
+
+func TestValidator_MaxLengthAndGrace(t *testing.T) {
+	config := DefaultConfig()
+	config.MaxLengths["description"] = 5
+	config.DeadlineGrace = 0
+	v, err := New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	task := validTask()
+	task.Deadline = time.Now().Add(-time.Minute).Unix()
+	want := map[string]string{
+		"description": "description is longer than 5 characters",
+		"deadline":    "deadline is set in the past",
+	}
+	if diff := cmp.Diff(want, FieldViolations(v.ValidateTask(task, true))); diff != "" {
+		t.Errorf("FieldViolations (-want,+got):%v", diff)
+	}
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	for name, config := range map[string]Config{
+		"unknown field":   {Required: []string{"owner"}},
+		"length of int":   {MaxLengths: map[string]int{"deadline": 3}},
+		"invalid pattern": {Lists: map[string]ListRules{"a": {Patterns: map[string]string{"title": "("}}}},
+	} {
+		if _, err := New(config); err == nil {
+			t.Errorf("New(%s) succeeded", name)
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "validation.json")
+	data := `{"deadlineMaxAhead": "720h", "maxLengths": {"title": 80}, "lists": {"ops": {"required": ["list"]}}}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	want := DefaultConfig()
+	want.DeadlineMaxAhead = Duration(720 * time.Hour)
+	want.MaxLengths["title"] = 80
+	want.Lists = map[string]ListRules{"ops": {Required: []string{"list"}}}
+	if diff := cmp.Diff(want, config); diff != "" {
+		t.Errorf("LoadConfig (-want,+got):%v", diff)
+	}
+}
+
+func TestValidator_DeadlineBoundaries(t *testing.T) {
+	now := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	v, err := New(DefaultConfig(), WithClock(clock.NewFake(now)))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	for name, tc := range map[string]struct {
+		deadline time.Time
+		valid    bool
+	}{
+		"at the grace limit":   {now.Add(-5 * time.Minute), true},
+		"past the grace limit": {now.Add(-5*time.Minute - time.Second), false},
+		"at the far limit":     {now.Add(10 * 365 * 24 * time.Hour), true},
+		"beyond the far limit": {now.Add(10*365*24*time.Hour + time.Second), false},
+	} {
+		task := validTask()
+		task.Deadline = tc.deadline.Unix()
+		if err := v.ValidateTask(task, false); (err == nil) != tc.valid {
+			t.Errorf("%s: ValidateTask() = %v, want valid %v", name, err, tc.valid)
+		}
+	}
+}
+
+func TestValidator_UpdateOverdueTask(t *testing.T) {
+	now := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+	v, err := New(DefaultConfig(), WithClock(clock.NewFake(now)))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	before := validTask()
+	before.Deadline = now.Add(-time.Hour).Unix()
+
+	task := validTask()
+	task.Deadline = before.Deadline
+	task.Complete = true
+	if err := v.ValidateUpdate(task, before); err != nil {
+		t.Errorf("Completing an overdue task was rejected: %v", err)
+	}
+
+	task.Deadline = now.Add(-2 * time.Hour).Unix()
+	want := map[string]string{"deadline": "deadline is set in the past"}
+	if diff := cmp.Diff(want, FieldViolations(v.ValidateUpdate(task, before))); diff != "" {
+		t.Errorf("Moving the deadline into the past (-want,+got):%v", diff)
+	}
+}
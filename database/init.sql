@@ -1,4 +1,3 @@
-
 CREATE TABLE IF NOT EXISTS tasks (
     taskId INTEGER PRIMARY KEY AUTOINCREMENT,
     title VARCHAR(255),
@@ -6,5 +5,249 @@ CREATE TABLE IF NOT EXISTS tasks (
     deadline INTEGER,  -- You can store timestamps
     exitCriteria TEXT,
     complete INTEGER,   -- Use INTEGER to represent BOOLEAN (0 for false, 1 for true)
-    UNIQUE (title, deadline, description, exitCriteria)  -- Composite unique index
+    deletedAt INTEGER DEFAULT 0,  -- Unix time the task was moved to the trash, 0 while it is active
+    version INTEGER DEFAULT 1,    -- Incremented on every change for optimistic concurrency control
+    updateTime INTEGER DEFAULT 0, -- Unix time of the last change
+    list TEXT DEFAULT '',         -- List the task belongs to
+    deadlineDate TEXT DEFAULT '', -- YYYY-MM-DD of an all-day deadline, empty for a deadline at a time of day
+    tags TEXT DEFAULT '',         -- Sorted tags separated by spaces
+    priority INTEGER DEFAULT 0,   -- Priority enum, 0 if unset
+    startAt INTEGER DEFAULT 0,    -- Unix time the task shows up in lists, 0 from the start
+    createdAt INTEGER DEFAULT 0,  -- Unix time the task was created, 0 for tasks created before it was recorded
+    completedAt INTEGER DEFAULT 0, -- Unix time the task was completed, 0 while it is not complete
+    assignee TEXT DEFAULT '',     -- User responsible for the task
+    dependsOn TEXT DEFAULT '',    -- Ids of the tasks that must be complete first, separated by spaces
+    estimateMinutes INTEGER DEFAULT 0, -- Estimated effort, 0 if unknown
+    overdueAt INTEGER DEFAULT 0,  -- Unix time the overdue job found the task open past its deadline, 0 while it is not overdue
+    trackedSeconds INTEGER DEFAULT 0, -- Sum of the seconds of the stopped time entries of the task
+    focusSessions INTEGER DEFAULT 0   -- Focus sessions run to their end on the task
+);
+
+-- Tasks in the trash don't count, so a trashed task can be created again
+CREATE UNIQUE INDEX IF NOT EXISTS tasks_unique ON tasks (title, deadline, description, exitCriteria) WHERE deletedAt = 0;
+
+CREATE INDEX IF NOT EXISTS tasks_deleted_at ON tasks (deletedAt);
+CREATE INDEX IF NOT EXISTS tasks_list ON tasks (list);
+CREATE INDEX IF NOT EXISTS tasks_completed_at ON tasks (completedAt);
+CREATE INDEX IF NOT EXISTS tasks_overdue_at ON tasks (overdueAt);
+
+-- Append-only log of every change made to a task, with JSON snapshots of the task before and after
+CREATE TABLE IF NOT EXISTS task_history (
+    historyId INTEGER PRIMARY KEY AUTOINCREMENT,
+    taskId INTEGER NOT NULL,  -- Not a foreign key, the history outlives purged tasks
+    action TEXT NOT NULL,     -- create, update, delete, restore, purge, undo or redo
+    actor TEXT NOT NULL,
+    changedAt INTEGER NOT NULL,
+    before TEXT,              -- NULL when the task did not exist before
+    after TEXT,               -- NULL when the task was purged
+    revertsId INTEGER         -- For undo and redo, the history entry that was reverted
+);
+
+CREATE INDEX IF NOT EXISTS task_history_task ON task_history (taskId, historyId);
+CREATE INDEX IF NOT EXISTS task_history_actor ON task_history (actor, historyId);
+CREATE INDEX IF NOT EXISTS task_history_reverts ON task_history (revertsId);
+
+CREATE TRIGGER IF NOT EXISTS task_history_no_update BEFORE UPDATE ON task_history
+BEGIN
+    SELECT RAISE(ABORT, 'task_history is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS task_history_no_delete BEFORE DELETE ON task_history
+BEGIN
+    SELECT RAISE(ABORT, 'task_history is append-only');
+END;
+
+-- URLs that task events are POSTed to
+CREATE TABLE IF NOT EXISTS webhooks (
+    webhookId INTEGER PRIMARY KEY AUTOINCREMENT,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,     -- HMAC-SHA256 key used to sign the deliveries
+    eventTypes TEXT NOT NULL, -- Comma separated EventType names, empty for every event
+    active INTEGER NOT NULL DEFAULT 1,
+    createdAt INTEGER NOT NULL
+);
+
+-- Delivery log and outbox of the webhooks, queued in the transaction that made the change
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    deliveryId INTEGER PRIMARY KEY AUTOINCREMENT,
+    webhookId INTEGER NOT NULL,
+    sequence INTEGER NOT NULL,     -- historyId of the event
+    eventType TEXT NOT NULL,
+    payload TEXT NOT NULL,         -- JSON encoded TaskEvent
+    status TEXT NOT NULL,          -- pending, delivered or dead
+    attempts INTEGER NOT NULL DEFAULT 0,
+    responseCode INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    createdAt INTEGER NOT NULL,
+    nextAttemptAt INTEGER NOT NULL,
+    deliveredAt INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due ON webhook_deliveries (status, nextAttemptAt);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook ON webhook_deliveries (webhookId, deliveryId);
+
+-- Responses of requests made with an idempotency key, replayed when the request is retried
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    actor TEXT NOT NULL,          -- Keys are scoped to the user that sent them
+    method TEXT NOT NULL,
+    idempotencyKey TEXT NOT NULL,
+    requestHash TEXT NOT NULL,    -- SHA-256 of the request, a key cannot be reused for a different request
+    response TEXT NOT NULL,       -- JSON encoded response of the first request
+    createdAt INTEGER NOT NULL,
+    PRIMARY KEY (actor, method, idempotencyKey)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_created ON idempotency_keys (createdAt);
+
+-- Preferences of each user, keyed by the actor name
+CREATE TABLE IF NOT EXISTS user_settings (
+    user TEXT PRIMARY KEY,
+    timeZone TEXT NOT NULL DEFAULT '',  -- IANA time zone name, UTC if empty
+    suggestionWeights TEXT NOT NULL DEFAULT '',  -- JSON encoded SuggestionWeights, the defaults if empty
+    updatedAt INTEGER NOT NULL
+);
+
+-- The state of a task after every change to its state, list or tags, for the per-day series
+CREATE TABLE IF NOT EXISTS task_transitions (
+    transitionId INTEGER PRIMARY KEY AUTOINCREMENT,
+    taskId INTEGER NOT NULL,
+    state TEXT NOT NULL,           -- open, completed or deleted
+    list TEXT NOT NULL DEFAULT '',
+    tags TEXT NOT NULL DEFAULT '', -- Sorted tags separated by spaces, like tasks.tags
+    at INTEGER NOT NULL            -- Unix time of the change
+);
+
+CREATE INDEX IF NOT EXISTS task_transitions_at ON task_transitions (at, taskId);
+
+-- Rules filling in the tags, priority, list and assignee of new tasks whose text matches
+CREATE TABLE IF NOT EXISTS category_rules (
+    ruleId INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL DEFAULT '',
+    fields TEXT NOT NULL DEFAULT '',   -- Fields matched separated by spaces, title and description if empty
+    keyword TEXT NOT NULL DEFAULT '',
+    pattern TEXT NOT NULL DEFAULT '',  -- Regular expression, used instead of the keyword when set
+    addTags TEXT NOT NULL DEFAULT '',  -- Sorted tags separated by spaces, like tasks.tags
+    priority INTEGER NOT NULL DEFAULT 0,
+    list TEXT NOT NULL DEFAULT '',
+    assignee TEXT NOT NULL DEFAULT '',
+    active INTEGER NOT NULL DEFAULT 1,
+    createdAt INTEGER NOT NULL
+);
+
+-- "When X then Y" rules run on task events and on tasks becoming overdue
+CREATE TABLE IF NOT EXISTS automations (
+    automationId INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL DEFAULT '',
+    triggerType TEXT NOT NULL,          -- Trigger name, e.g. WHEN_COMPLETED
+    definition TEXT NOT NULL,           -- JSON encoded Automation holding the condition and the actions
+    active INTEGER NOT NULL DEFAULT 1,
+    createdAt INTEGER NOT NULL
+);
+
+-- Execution log of the automations, also remembers which overdue tasks were handled
+CREATE TABLE IF NOT EXISTS automation_runs (
+    runId INTEGER PRIMARY KEY AUTOINCREMENT,
+    automationId INTEGER NOT NULL,
+    taskId INTEGER NOT NULL,
+    triggerType TEXT NOT NULL,
+    triggerKey TEXT NOT NULL,           -- event:<sequence> for events, overdue:<deadline> for overdue tasks
+    depth INTEGER NOT NULL DEFAULT 0,   -- Number of automations in the chain that led to this run
+    status TEXT NOT NULL,               -- succeeded, failed or skipped
+    error TEXT NOT NULL DEFAULT '',
+    changes TEXT NOT NULL DEFAULT '',   -- What the actions did, one per line
+    at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS automation_runs_key ON automation_runs (automationId, taskId, triggerKey);
+
+-- Messages for users, sent by automations
+CREATE TABLE IF NOT EXISTS notifications (
+    notificationId INTEGER PRIMARY KEY AUTOINCREMENT,
+    recipient TEXT NOT NULL,
+    taskId INTEGER NOT NULL,
+    automationId INTEGER NOT NULL DEFAULT 0,  -- 0 if no automation sent it
+    policyId INTEGER NOT NULL DEFAULT 0,      -- 0 if no escalation policy sent it
+    message TEXT NOT NULL,
+    createdAt INTEGER NOT NULL,
+    readAt INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS notifications_recipient ON notifications (recipient, notificationId);
+
+-- Who is notified while overdue tasks stay open, per list; the policy with an empty list is the default
+CREATE TABLE IF NOT EXISTS escalation_policies (
+    policyId INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL DEFAULT '',
+    list TEXT NOT NULL UNIQUE,
+    steps TEXT NOT NULL,                -- JSON encoded EscalationPolicy holding the steps
+    active INTEGER NOT NULL DEFAULT 1,
+    createdAt INTEGER NOT NULL
+);
+
+-- Users notified by the LIST_ADMINS escalation steps
+CREATE TABLE IF NOT EXISTS list_admins (
+    list TEXT NOT NULL,
+    admin TEXT NOT NULL,
+    PRIMARY KEY (list, admin)
+);
+
+-- How far each overdue task got in its escalation, so no step notifies twice
+CREATE TABLE IF NOT EXISTS task_escalations (
+    taskId INTEGER NOT NULL,
+    deadline INTEGER NOT NULL,          -- The deadline that passed, a new deadline starts over
+    policyId INTEGER NOT NULL,
+    stepsDone INTEGER NOT NULL,         -- Number of steps of the policy that ran
+    updatedAt INTEGER NOT NULL,
+    PRIMARY KEY (taskId, deadline)
+);
+
+-- Time spent on tasks, logged with timers or by hand
+CREATE TABLE IF NOT EXISTS time_entries (
+    entryId INTEGER PRIMARY KEY AUTOINCREMENT,
+    taskId INTEGER NOT NULL,
+    user TEXT NOT NULL,
+    startedAt INTEGER NOT NULL,
+    endedAt INTEGER NOT NULL DEFAULT 0,   -- 0 while the timer runs
+    seconds INTEGER NOT NULL DEFAULT 0,   -- endedAt - startedAt, 0 while the timer runs
+    note TEXT NOT NULL DEFAULT '',
+    manual INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS time_entries_task ON time_entries (taskId, entryId);
+CREATE INDEX IF NOT EXISTS time_entries_user ON time_entries (user, startedAt);
+CREATE UNIQUE INDEX IF NOT EXISTS time_entries_running ON time_entries (user) WHERE endedAt = 0;  -- One running timer per user
+
+-- Pomodoro focus sessions, each timed with a time entry
+CREATE TABLE IF NOT EXISTS focus_sessions (
+    sessionId INTEGER PRIMARY KEY AUTOINCREMENT,
+    entryId INTEGER NOT NULL,
+    taskId INTEGER NOT NULL,
+    user TEXT NOT NULL,
+    startedAt INTEGER NOT NULL,
+    plannedSeconds INTEGER NOT NULL,
+    endedAt INTEGER NOT NULL DEFAULT 0,   -- 0 while focusing
+    state INTEGER NOT NULL                -- FocusState
+);
+
+CREATE INDEX IF NOT EXISTS focus_sessions_entry ON focus_sessions (entryId);
+CREATE INDEX IF NOT EXISTS focus_sessions_user ON focus_sessions (user, startedAt);
+CREATE INDEX IF NOT EXISTS focus_sessions_task ON focus_sessions (taskId, sessionId);
+
+CREATE TABLE IF NOT EXISTS focus_interruptions (
+    interruptionId INTEGER PRIMARY KEY AUTOINCREMENT,
+    sessionId INTEGER NOT NULL,
+    at INTEGER NOT NULL,
+    note TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS focus_interruptions_session ON focus_interruptions (sessionId, interruptionId);
+
+-- Templates of task trees created again and again
+CREATE TABLE IF NOT EXISTS task_templates (
+    templateId INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    list TEXT NOT NULL DEFAULT '',
+    task TEXT NOT NULL,        -- The root TemplateTask as protojson
+    createdAt INTEGER NOT NULL
 );